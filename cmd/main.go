@@ -39,16 +39,21 @@ func main() {
 	conf := config.MustLoad(configPath)
 	mustRegisterLogger(conf.Mode)
 
-	au := auth.New(conf)
 	repo := db.New(conf)
+	au := auth.New(conf, repo)
 	svc := ctrl.New(repo, au)
+	svc.SetReopenWindow(conf.Reception.ReopenWindow)
 	hdl := http.New(svc, au)
-	ghdl := grpc.New(conf.ServiceName, svc)
+	ghdl := grpc.New(conf.ServiceName, svc, au)
 
 	go prometheus.New(conf.Prometheus.Port).Start(ctx)
 	go hdl.Start(conf.Server.Port)
 	go ghdl.Start(conf.Server.GRPCPort)
 
+	if conf.AutoClose.IdleTimeout > 0 {
+		go svc.AutoCloseReceptions(ctx, conf.AutoClose.IdleTimeout, conf.AutoClose.Interval)
+	}
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	<-c
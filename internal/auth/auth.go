@@ -2,23 +2,34 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"github.com/JMURv/avito-spring/internal/config"
 	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"slices"
+	"strings"
 	"time"
 )
 
 const issuer = "avito-spring"
-const tokenDuration = time.Hour * 2
+const TokenDuration = time.Hour * 2
+const RefreshTokenDuration = time.Hour * 24 * 30
 
 type Core interface {
 	Hash(val string) (string, error)
 	ComparePasswords(hashed, pswd []byte) error
 	NewToken(uid uuid.UUID, role string) (string, error)
 	ParseClaims(ctx context.Context, tokenStr string) (Claims, error)
+	NewRefreshToken() (string, error)
+	HashToken(token string) string
+	MarkRevoked(jti string, until time.Time)
+	JWKS() JWKS
 }
 
 type Claims struct {
@@ -28,12 +39,31 @@ type Claims struct {
 }
 
 type Auth struct {
-	secret []byte
+	secret  []byte
+	hs256   bool
+	keys    map[string]*key
+	signing *key
+	store   RevocationStore
+	revoked *revocationCache
 }
 
-func New(conf config.Config) *Auth {
+// New signs tokens with the configured signing key, or with HS256 and conf.Secret when
+// there is none. Tokens carrying a kid are verified with the matching key, so a key can
+// be rotated by adding it, switching signingKey to it and dropping the old one once the
+// tokens it signed have expired. With jwt.disableHS256 tokens without a kid are rejected.
+func New(conf config.Config, store RevocationStore) *Auth {
+	keys, signing, err := loadKeys(conf.JWT)
+	if err != nil {
+		zap.L().Fatal("Failed to load JWT keys", zap.Error(err))
+	}
+
 	return &Auth{
-		secret: []byte(conf.Secret),
+		secret:  []byte(conf.Secret),
+		hs256:   !conf.JWT.DisableHS256,
+		keys:    keys,
+		signing: signing,
+		store:   store,
+		revoked: newRevocationCache(),
 	}
 }
 
@@ -58,17 +88,26 @@ func (a *Auth) ComparePasswords(hashed, pswd []byte) error {
 }
 
 func (a *Auth) NewToken(uid uuid.UUID, role string) (string, error) {
-	signed, err := jwt.NewWithClaims(
-		jwt.SigningMethodHS256, &Claims{
-			UID:  uid,
-			Role: role,
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(tokenDuration)),
-				IssuedAt:  jwt.NewNumericDate(time.Now()),
-				Issuer:    issuer,
-			},
+	claims := &Claims{
+		UID:  uid,
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(TokenDuration)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    issuer,
 		},
-	).SignedString(a.secret)
+	}
+
+	var signed string
+	var err error
+	if a.signing != nil {
+		token := jwt.NewWithClaims(a.signing.method, claims)
+		token.Header["kid"] = a.signing.kid
+		signed, err = token.SignedString(a.signing.private)
+	} else {
+		signed, err = jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(a.secret)
+	}
 
 	if err != nil {
 		zap.L().Error(
@@ -82,38 +121,124 @@ func (a *Auth) NewToken(uid uuid.UUID, role string) (string, error) {
 	return signed, nil
 }
 
-func (a *Auth) ParseClaims(_ context.Context, tokenStr string) (Claims, error) {
+func (a *Auth) ParseClaims(ctx context.Context, tokenStr string) (Claims, error) {
 	claims := Claims{}
-	token, err := jwt.ParseWithClaims(
-		tokenStr, &claims, func(token *jwt.Token) (any, error) {
-			if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-				return nil, ErrUnexpectedSignMethod
-			}
-			return a.secret, nil
-		},
-	)
-
+	token, err := jwt.ParseWithClaims(tokenStr, &claims, a.verificationKey)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenMalformed) {
 			return claims, err
 		}
 
+		alg := ""
+		if token != nil && token.Method != nil {
+			alg = token.Method.Alg()
+		}
 		zap.L().Error(
 			"Failed to parse claims",
-			zap.String("token", tokenStr),
-			zap.String("alg", token.Method.Alg()),
+			zap.String("alg", alg),
 			zap.Error(err),
 		)
 		return claims, err
 	}
 
 	if !token.Valid {
-		zap.L().Debug(
-			"Token is invalid",
-			zap.String("token", tokenStr),
-		)
+		zap.L().Debug("Token is invalid", zap.String("jti", claims.ID))
 		return claims, ErrInvalidToken
 	}
 
+	revoked, err := a.isRevoked(ctx, claims)
+	if err != nil {
+		zap.L().Error("Failed to check token revocation", zap.String("jti", claims.ID), zap.Error(err))
+		return claims, err
+	}
+	if revoked {
+		zap.L().Debug("Token is revoked", zap.String("jti", claims.ID))
+		return claims, ErrTokenRevoked
+	}
+
 	return claims, nil
 }
+
+// verificationKey picks the key by the kid header. Tokens without one are HS256 tokens, accepted
+// only while HS256 is not disabled.
+func (a *Auth) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if !a.hs256 {
+			return nil, ErrKeyIDIsMissing
+		}
+		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return nil, ErrUnexpectedSignMethod
+		}
+		return a.secret, nil
+	}
+
+	k, ok := a.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	if token.Method.Alg() != k.method.Alg() {
+		return nil, ErrUnexpectedSignMethod
+	}
+	return k.public, nil
+}
+
+// JWKS returns the public part of every configured key, including verify-only ones.
+func (a *Auth) JWKS() JWKS {
+	res := JWKS{Keys: make([]JWK, 0, len(a.keys))}
+	for _, k := range a.keys {
+		res.Keys = append(res.Keys, k.jwk())
+	}
+
+	slices.SortFunc(
+		res.Keys, func(a, b JWK) int {
+			return strings.Compare(a.Kid, b.Kid)
+		},
+	)
+	return res
+}
+
+// NewRefreshToken returns an opaque random token. Only its hash is meant to be stored.
+func (a *Auth) NewRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		zap.L().Error(ErrWhileCreatingToken.Error(), zap.Error(err))
+		return "", ErrWhileCreatingToken
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (a *Auth) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// MarkRevoked records a revocation made by this replica so it takes effect without a store lookup.
+func (a *Auth) MarkRevoked(jti string, until time.Time) {
+	a.revoked.set(jti, true, until)
+}
+
+// isRevoked consults the cache first and falls back to the store. Tokens issued without
+// a jti cannot be revoked and expire on their own.
+func (a *Auth) isRevoked(ctx context.Context, claims Claims) (bool, error) {
+	if claims.ID == "" || a.store == nil {
+		return false, nil
+	}
+
+	now := time.Now()
+	if revoked, ok := a.revoked.get(claims.ID, now); ok {
+		return revoked, nil
+	}
+
+	revoked, err := a.store.IsTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+
+	until := now.Add(negativeTTL)
+	if revoked && claims.ExpiresAt != nil {
+		until = claims.ExpiresAt.Time
+	}
+	a.revoked.set(claims.ID, revoked, until)
+	return revoked, nil
+}
@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/config"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type fakeStore struct {
+	revoked map[string]bool
+	calls   int
+	err     error
+}
+
+func (s *fakeStore) IsTokenRevoked(_ context.Context, jti string) (bool, error) {
+	s.calls++
+	return s.revoked[jti], s.err
+}
+
+func TestAuth_ParseClaims_Revocation(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{revoked: map[string]bool{}}
+	au := New(config.Config{Secret: "secret"}, store)
+
+	token, err := au.NewToken(uuid.New(), "employee")
+	require.NoError(t, err)
+
+	claims, err := au.ParseClaims(ctx, token)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.ID)
+	assert.Equal(t, 1, store.calls)
+
+	_, err = au.ParseClaims(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.calls, "not revoked result is served from cache")
+
+	au.MarkRevoked(claims.ID, time.Now().Add(time.Minute))
+	_, err = au.ParseClaims(ctx, token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+	assert.Equal(t, 1, store.calls)
+
+	other, err := au.NewToken(uuid.New(), "employee")
+	require.NoError(t, err)
+	otherClaims, err := au.ParseClaims(ctx, other)
+	require.NoError(t, err)
+
+	// revoked on another replica: visible once the cached negative answer expires
+	store.revoked[otherClaims.ID] = true
+	au.revoked.set(otherClaims.ID, false, time.Now().Add(-time.Second))
+	_, err = au.ParseClaims(ctx, other)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	store.err = errors.New("db down")
+	third, err := au.NewToken(uuid.New(), "employee")
+	require.NoError(t, err)
+	_, err = au.ParseClaims(ctx, third)
+	assert.ErrorIs(t, err, store.err)
+}
+
+func TestAuth_ParseClaims_UnknownAlg(t *testing.T) {
+	au := New(config.Config{Secret: "secret"}, nil)
+
+	enc := base64.RawURLEncoding.EncodeToString
+	token := enc([]byte(`{"alg":"XX256","typ":"JWT"}`)) + "." + enc([]byte(`{"roles":"moderator"}`)) + "." + enc([]byte("sig"))
+	assert.NotPanics(
+		t, func() {
+			_, err := au.ParseClaims(context.Background(), token)
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestAuth_HashToken(t *testing.T) {
+	au := New(config.Config{Secret: "secret"}, nil)
+
+	token, err := au.NewRefreshToken()
+	require.NoError(t, err)
+	other, err := au.NewRefreshToken()
+	require.NoError(t, err)
+
+	assert.NotEqual(t, token, other)
+	assert.Equal(t, au.HashToken(token), au.HashToken(token))
+	assert.NotEqual(t, au.HashToken(token), au.HashToken(other))
+	assert.Len(t, au.HashToken(token), 64)
+}
+
+func writePEM(t *testing.T, dir, name, typ string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return path
+}
+
+func TestAuth_AsymmetricKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaDER, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+	rsaPubDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	rsaPriv := writePEM(t, dir, "old.pem", "PRIVATE KEY", rsaDER)
+	rsaPub := writePEM(t, dir, "old.pub.pem", "PUBLIC KEY", rsaPubDER)
+	edPriv := writePEM(t, dir, "new.pem", "PRIVATE KEY", edDER)
+
+	before := New(
+		config.Config{
+			JWT: config.JWTConfig{
+				SigningKey: "old",
+				Keys:       []config.JWTKeyConfig{{Kid: "old", Alg: "RS256", PrivateKey: rsaPriv}},
+			},
+		}, nil,
+	)
+	oldToken, err := before.NewToken(uuid.New(), "moderator")
+	require.NoError(t, err)
+
+	// after rotation the old key is kept for verification only
+	after := New(
+		config.Config{
+			Secret: "secret",
+			JWT: config.JWTConfig{
+				SigningKey: "new",
+				Keys: []config.JWTKeyConfig{
+					{Kid: "new", Alg: "EdDSA", PrivateKey: edPriv},
+					{Kid: "old", Alg: "RS256", PublicKey: rsaPub},
+				},
+			},
+		}, nil,
+	)
+
+	newToken, err := after.NewToken(uuid.New(), "employee")
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"])
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+
+	claims, err := after.ParseClaims(ctx, newToken)
+	require.NoError(t, err)
+	assert.Equal(t, "employee", claims.Role)
+
+	claims, err = after.ParseClaims(ctx, oldToken)
+	require.NoError(t, err)
+	assert.Equal(t, "moderator", claims.Role)
+
+	_, err = before.ParseClaims(ctx, newToken)
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+
+	legacy, err := New(config.Config{Secret: "secret"}, nil).NewToken(uuid.New(), "employee")
+	require.NoError(t, err)
+	_, err = after.ParseClaims(ctx, legacy)
+	assert.NoError(t, err, "HS256 tokens without kid keep working during migration")
+
+	// once the migration is over HS256 tokens are refused
+	strict := New(
+		config.Config{
+			Secret: "secret",
+			JWT: config.JWTConfig{
+				SigningKey:   "new",
+				Keys:         []config.JWTKeyConfig{{Kid: "new", Alg: "EdDSA", PrivateKey: edPriv}},
+				DisableHS256: true,
+			},
+		}, nil,
+	)
+	_, err = strict.ParseClaims(ctx, legacy)
+	assert.ErrorIs(t, err, ErrKeyIDIsMissing)
+	_, err = strict.ParseClaims(ctx, newToken)
+	assert.NoError(t, err)
+
+	jwks := after.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "new", jwks.Keys[0].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(edKey.Public().(ed25519.PublicKey)), jwks.Keys[0].X)
+	assert.Equal(t, "old", jwks.Keys[1].Kid)
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+	assert.Equal(t, "AQAB", jwks.Keys[1].E)
+}
+
+func TestLoadKeys_Errors(t *testing.T) {
+	dir := t.TempDir()
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDER, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	edPriv := writePEM(t, dir, "k.pem", "PRIVATE KEY", edDER)
+
+	tests := []struct {
+		name    string
+		conf    config.JWTConfig
+		wantErr error
+	}{
+		{
+			name:    "Missing kid",
+			conf:    config.JWTConfig{Keys: []config.JWTKeyConfig{{Alg: "EdDSA", PrivateKey: edPriv}}},
+			wantErr: ErrKeyIDIsMissing,
+		},
+		{
+			name: "Duplicate kid",
+			conf: config.JWTConfig{
+				Keys: []config.JWTKeyConfig{
+					{Kid: "a", Alg: "EdDSA", PrivateKey: edPriv},
+					{Kid: "a", Alg: "EdDSA", PrivateKey: edPriv},
+				},
+			},
+			wantErr: ErrDuplicateKeyID,
+		},
+		{
+			name:    "Unsupported alg",
+			conf:    config.JWTConfig{Keys: []config.JWTKeyConfig{{Kid: "a", Alg: "HS512", PrivateKey: edPriv}}},
+			wantErr: ErrUnsupportedAlg,
+		},
+		{
+			name:    "No key files",
+			conf:    config.JWTConfig{Keys: []config.JWTKeyConfig{{Kid: "a", Alg: "EdDSA"}}},
+			wantErr: ErrKeyFileIsMissing,
+		},
+		{
+			name:    "Unknown signing key",
+			conf:    config.JWTConfig{SigningKey: "b", Keys: []config.JWTKeyConfig{{Kid: "a", Alg: "EdDSA", PrivateKey: edPriv}}},
+			wantErr: ErrSigningKeyNotFound,
+		},
+		{
+			name:    "HS256 disabled without signing key",
+			conf:    config.JWTConfig{Keys: []config.JWTKeyConfig{{Kid: "a", Alg: "EdDSA", PrivateKey: edPriv}}, DisableHS256: true},
+			wantErr: ErrHS256IsRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, _, err := loadKeys(tt.conf)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+}
@@ -6,3 +6,12 @@ var ErrInvalidToken = errors.New("invalid token")
 var ErrInvalidCredentials = errors.New("invalid credentials")
 var ErrWhileCreatingToken = errors.New("error while creating token")
 var ErrUnexpectedSignMethod = errors.New("unexpected signing method")
+var ErrTokenRevoked = errors.New("token revoked")
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+var ErrUnknownKeyID = errors.New("unknown key id")
+var ErrKeyIDIsMissing = errors.New("key id is missing")
+var ErrDuplicateKeyID = errors.New("duplicate key id")
+var ErrSigningKeyNotFound = errors.New("signing key not found or has no private key")
+var ErrUnsupportedAlg = errors.New("unsupported signing algorithm")
+var ErrKeyFileIsMissing = errors.New("either private or public key file is required")
+var ErrHS256IsRequired = errors.New("HS256 cannot be disabled without a signing key")
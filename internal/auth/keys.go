@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"github.com/JMURv/avito-spring/internal/config"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"os"
+)
+
+type key struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// JWK is a public key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// loadKeys reads every configured key and returns them by kid together with the signing key.
+func loadKeys(conf config.JWTConfig) (map[string]*key, *key, error) {
+	keys := make(map[string]*key, len(conf.Keys))
+	for _, kc := range conf.Keys {
+		if kc.Kid == "" {
+			return nil, nil, ErrKeyIDIsMissing
+		}
+		if _, ok := keys[kc.Kid]; ok {
+			return nil, nil, fmt.Errorf("%w: %s", ErrDuplicateKeyID, kc.Kid)
+		}
+
+		k, err := loadKey(kc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("key %s: %w", kc.Kid, err)
+		}
+		keys[kc.Kid] = k
+	}
+
+	if conf.SigningKey == "" {
+		if conf.DisableHS256 {
+			return nil, nil, ErrHS256IsRequired
+		}
+		return keys, nil, nil
+	}
+
+	signing, ok := keys[conf.SigningKey]
+	if !ok || signing.private == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrSigningKeyNotFound, conf.SigningKey)
+	}
+	return keys, signing, nil
+}
+
+func loadKey(kc config.JWTKeyConfig) (*key, error) {
+	k := &key{kid: kc.Kid}
+	switch kc.Alg {
+	case jwt.SigningMethodRS256.Alg():
+		k.method = jwt.SigningMethodRS256
+	case jwt.SigningMethodEdDSA.Alg():
+		k.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlg, kc.Alg)
+	}
+
+	if kc.PrivateKey != "" {
+		data, err := os.ReadFile(kc.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.method == jwt.SigningMethodRS256 {
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, err
+			}
+			k.private, k.public = priv, &priv.PublicKey
+		} else {
+			priv, err := jwt.ParseEdPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, err
+			}
+			k.private, k.public = priv, priv.(ed25519.PrivateKey).Public()
+		}
+	}
+
+	if kc.PublicKey != "" {
+		data, err := os.ReadFile(kc.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.method == jwt.SigningMethodRS256 {
+			k.public, err = jwt.ParseRSAPublicKeyFromPEM(data)
+		} else {
+			k.public, err = jwt.ParseEdPublicKeyFromPEM(data)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if k.public == nil {
+		return nil, ErrKeyFileIsMissing
+	}
+	return k, nil
+}
+
+func (k *key) jwk() JWK {
+	res := JWK{
+		Kid: k.kid,
+		Use: "sig",
+		Alg: k.method.Alg(),
+	}
+
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		res.Kty = "RSA"
+		res.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		res.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		res.Kty = "OKP"
+		res.Crv = "Ed25519"
+		res.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return res
+}
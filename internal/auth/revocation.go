@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RevocationStore reports whether an access token id was revoked before its expiry.
+type RevocationStore interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// negativeTTL bounds how long a replica keeps accepting a token that was revoked on another one.
+const negativeTTL = 30 * time.Second
+
+// pruneThreshold is the cache size after which expired entries are swept on insert.
+const pruneThreshold = 4096
+
+type cacheEntry struct {
+	revoked bool
+	until   time.Time
+}
+
+type revocationCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func newRevocationCache() *revocationCache {
+	return &revocationCache{
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *revocationCache) get(jti string, now time.Time) (revoked bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[jti]
+	if !ok {
+		return false, false
+	}
+	if now.After(e.until) {
+		delete(c.entries, jti)
+		return false, false
+	}
+	return e.revoked, true
+}
+
+func (c *revocationCache) set(jti string, revoked bool, until time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= pruneThreshold {
+		now := time.Now()
+		for k, e := range c.entries {
+			if now.After(e.until) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[jti] = cacheEntry{revoked: revoked, until: until}
+}
@@ -0,0 +1,94 @@
+package broker
+
+import (
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sync"
+)
+
+const subscriberBuffer = 64
+
+// Filter narrows a subscription down to a single PVZ and/or city. Zero values match everything.
+type Filter struct {
+	PVZID uuid.UUID
+	City  string
+}
+
+func (f Filter) match(e *md.Event) bool {
+	if f.PVZID != uuid.Nil && f.PVZID != e.PVZID {
+		return false
+	}
+	if f.City != "" && f.City != e.City {
+		return false
+	}
+	return true
+}
+
+type subscriber struct {
+	filter Filter
+	ch     chan *md.Event
+}
+
+// Broker fans out events to in-process subscribers. Publishing never blocks:
+// events are dropped for subscribers whose buffer is full.
+type Broker struct {
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+func New() *Broker {
+	return &Broker{
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *Broker) Subscribe(filter Filter) (<-chan *md.Event, func()) {
+	sub := &subscriber{
+		filter: filter,
+		ch:     make(chan *md.Event, subscriberBuffer),
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(
+			func() {
+				b.mu.Lock()
+				delete(b.subs, sub)
+				b.mu.Unlock()
+				close(sub.ch)
+			},
+		)
+	}
+}
+
+func (b *Broker) HasSubscribers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs) > 0
+}
+
+func (b *Broker) Publish(e *md.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subs {
+		if !sub.filter.match(e) {
+			continue
+		}
+
+		select {
+		case sub.ch <- e:
+		default:
+			zap.L().Debug(
+				"Subscriber is too slow, dropping event",
+				zap.String("type", e.Type),
+				zap.String("pvz", e.PVZID.String()),
+			)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package broker
+
+import (
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	pvzID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name   string
+		filter Filter
+		events []*md.Event
+		want   int
+	}{
+		{
+			name:   "NoFilter",
+			filter: Filter{},
+			events: []*md.Event{
+				{PVZID: pvzID, City: "Москва"},
+				{PVZID: otherID, City: "Казань"},
+			},
+			want: 2,
+		},
+		{
+			name:   "ByPVZ",
+			filter: Filter{PVZID: pvzID},
+			events: []*md.Event{
+				{PVZID: pvzID, City: "Москва"},
+				{PVZID: otherID, City: "Москва"},
+			},
+			want: 1,
+		},
+		{
+			name:   "ByCity",
+			filter: Filter{City: "Казань"},
+			events: []*md.Event{
+				{PVZID: pvzID, City: "Москва"},
+				{PVZID: otherID, City: "Казань"},
+			},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				b := New()
+				ch, unsubscribe := b.Subscribe(tt.filter)
+				defer unsubscribe()
+
+				for _, e := range tt.events {
+					b.Publish(e)
+				}
+				assert.Len(t, ch, tt.want)
+			},
+		)
+	}
+}
+
+func TestBroker_FanOut(t *testing.T) {
+	b := New()
+	first, unsubFirst := b.Subscribe(Filter{})
+	second, unsubSecond := b.Subscribe(Filter{})
+	defer unsubSecond()
+
+	e := &md.Event{Type: md.EventProductAdded, PVZID: uuid.New()}
+	b.Publish(e)
+	assert.Equal(t, e, <-first)
+	assert.Equal(t, e, <-second)
+
+	unsubFirst()
+	unsubFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+	assert.True(t, b.HasSubscribers())
+
+	unsubSecond()
+	assert.False(t, b.HasSubscribers())
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	b := New()
+	ch, unsubscribe := b.Subscribe(Filter{})
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		b.Publish(&md.Event{PVZID: uuid.New()})
+	}
+	assert.Len(t, ch, subscriberBuffer)
+}
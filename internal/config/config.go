@@ -4,15 +4,37 @@ import (
 	"go.uber.org/zap"
 	yaml "gopkg.in/yaml.v3"
 	"os"
+	"time"
 )
 
 type Config struct {
 	Mode        string           `yaml:"mode"`
 	ServiceName string           `yaml:"serviceName"`
 	Secret      string           `yaml:"secret"`
+	JWT         JWTConfig        `yaml:"jwt"`
 	Server      ServerConfig     `yaml:"server"`
 	DB          DBConfig         `yaml:"db"`
 	Prometheus  PrometheusConfig `yaml:"prometheus"`
+	AutoClose   AutoCloseConfig  `yaml:"autoClose"`
+	Reception   ReceptionConfig  `yaml:"reception"`
+}
+
+// JWTConfig lists the asymmetric keys tokens are signed and verified with.
+// New tokens are signed with SigningKey, every other key is only used for verification.
+// Without keys tokens fall back to HS256 with Secret. DisableHS256 stops accepting tokens without a kid
+// once every HS256 token issued before the migration has expired.
+type JWTConfig struct {
+	SigningKey   string         `yaml:"signingKey"`
+	Keys         []JWTKeyConfig `yaml:"keys"`
+	DisableHS256 bool           `yaml:"disableHS256"`
+}
+
+// JWTKeyConfig points to PEM files of one key. PrivateKey may be omitted for verify-only keys.
+type JWTKeyConfig struct {
+	Kid        string `yaml:"kid"`
+	Alg        string `yaml:"alg"`
+	PrivateKey string `yaml:"privateKey"`
+	PublicKey  string `yaml:"publicKey"`
 }
 
 type ServerConfig struct {
@@ -30,6 +52,19 @@ type DBConfig struct {
 	Database string `yaml:"database"`
 }
 
+// AutoCloseConfig controls the job closing receptions without new products for IdleTimeout.
+// The job is disabled when IdleTimeout is zero, Interval is how often idle receptions are looked for.
+type AutoCloseConfig struct {
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+	Interval    time.Duration `yaml:"interval"`
+}
+
+// ReceptionConfig holds reception rules. ReopenWindow is how long after closing a reception
+// a moderator may reopen it, the controller default is used when it is zero.
+type ReceptionConfig struct {
+	ReopenWindow time.Duration `yaml:"reopenWindow"`
+}
+
 type PrometheusConfig struct {
 	Port int `yaml:"port"`
 }
@@ -0,0 +1,85 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func (c *Controller) ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error) {
+	res, err := c.repo.ListAssignments(ctx, uid)
+	if err != nil {
+		zap.L().Error("Failed to list assignments", zap.String("uid", uid.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error) {
+	res, err := c.repo.AssignPVZ(ctx, uid, pvzID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Employee or PVZ not found", zap.String("uid", uid.String()), zap.String("id", pvzID.String()))
+			return nil, ErrEmployeeOrPVZNotFound
+		}
+		zap.L().Error(
+			"Failed to assign PVZ",
+			zap.String("uid", uid.String()),
+			zap.String("id", pvzID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error {
+	err := c.repo.UnassignPVZ(ctx, uid, pvzID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Assignment not found", zap.String("uid", uid.String()), zap.String("id", pvzID.String()))
+			return ErrAssignmentNotFound
+		}
+		zap.L().Error(
+			"Failed to unassign PVZ",
+			zap.String("uid", uid.String()),
+			zap.String("id", pvzID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// checkScope allows employees to work only with the PVZs they are assigned to.
+// Other roles are not restricted here, route level checks decide what they can call.
+func (c *Controller) checkScope(ctx context.Context, pvzID uuid.UUID) error {
+	if role, _ := ctx.Value("role").(string); role != md.EmployeeRole {
+		return nil
+	}
+
+	uid := uidFromCtx(ctx)
+	ok, err := c.repo.IsAssigned(ctx, uid, pvzID)
+	if err != nil {
+		zap.L().Error(
+			"Failed to check PVZ assignment",
+			zap.String("uid", uid.String()),
+			zap.String("id", pvzID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if !ok {
+		zap.L().Debug("Employee is not assigned to PVZ", zap.String("uid", uid.String()), zap.String("id", pvzID.String()))
+		return ErrPVZForbidden
+	}
+	return nil
+}
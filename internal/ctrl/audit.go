@@ -0,0 +1,18 @@
+package ctrl
+
+import (
+	"context"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"go.uber.org/zap"
+)
+
+func (c *Controller) ListAudit(ctx context.Context, filter *md.AuditFilter) ([]*dto.AuditEntry, error) {
+	res, err := c.repo.ListAudit(ctx, filter)
+	if err != nil {
+		zap.L().Error("Failed to list audit log", zap.Any("filter", filter), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
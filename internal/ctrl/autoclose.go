@@ -0,0 +1,77 @@
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	md "github.com/JMURv/avito-spring/internal/models"
+	metrics "github.com/JMURv/avito-spring/internal/observability/metrics/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+// autoCloseBatchSize limits the number of receptions closed in a single transaction.
+const autoCloseBatchSize = 100
+
+const defaultAutoCloseInterval = 5 * time.Minute
+
+// AutoCloseReceptions closes idle receptions every interval until ctx is done.
+func (c *Controller) AutoCloseReceptions(ctx context.Context, idleFor, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultAutoCloseInterval
+	}
+
+	zap.L().Info(
+		"Starting reception auto close",
+		zap.Duration("idleFor", idleFor),
+		zap.Duration("interval", interval),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = c.CloseIdleReceptions(ctx, idleFor)
+		}
+	}
+}
+
+// CloseIdleReceptions closes every reception that had no products added for idleFor and returns how many were closed.
+// Receptions with a strict manifest are never closed automatically, since nobody checks them against the manifest.
+func (c *Controller) CloseIdleReceptions(ctx context.Context, idleFor time.Duration) (int, error) {
+	reason := fmt.Sprintf("no products added for %s", idleFor)
+
+	total := 0
+	for {
+		res, err := c.repo.CloseIdleReceptions(ctx, idleFor, reason, autoCloseBatchSize)
+		if err != nil {
+			zap.L().Error("Failed to close idle receptions", zap.Int("closed", total), zap.Error(err))
+			return total, err
+		}
+
+		for _, rec := range res {
+			zap.L().Info(
+				"Idle reception closed",
+				zap.String("id", rec.ID.String()),
+				zap.String("pvz", rec.PVZID.String()),
+				zap.String("reason", reason),
+			)
+
+			c.publish(
+				ctx, &md.Event{
+					Type:        md.EventReceptionClosed,
+					PVZID:       rec.PVZID,
+					ReceptionID: rec.ID,
+				},
+			)
+		}
+
+		total += len(res)
+		metrics.AutoClosedReceptions.Add(float64(len(res)))
+		if len(res) < autoCloseBatchSize {
+			return total, nil
+		}
+	}
+}
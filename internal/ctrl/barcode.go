@@ -0,0 +1,73 @@
+package ctrl
+
+import (
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+)
+
+const ean13Len = 13
+
+// maxCode128Len is the longest value a handheld scanner reads from a single Code 128 symbol.
+const maxCode128Len = 48
+
+// validateBarcode accepts EAN-13 values with a correct check digit and any other value that Code 128 can encode.
+// Code 128 keeps its modulo 103 check symbol in the bars only, scanners verify and strip it, so for those values
+// only the character set and length can be checked. Thirteen digit values are always treated as EAN-13.
+func validateBarcode(barcode string) error {
+	if len(barcode) == ean13Len && isDigits(barcode) {
+		if ean13CheckDigit(barcode[:ean13Len-1]) != barcode[ean13Len-1]-'0' {
+			return ErrBarcodeIsNotValid
+		}
+		return nil
+	}
+
+	if len(barcode) == 0 || len(barcode) > maxCode128Len {
+		return ErrBarcodeIsNotValid
+	}
+
+	// Code sets A and B together cover the printable ASCII characters.
+	for i := 0; i < len(barcode); i++ {
+		if barcode[i] < ' ' || barcode[i] > '~' {
+			return ErrBarcodeIsNotValid
+		}
+	}
+	return nil
+}
+
+// ean13CheckDigit computes the check digit of the first twelve digits of an EAN-13 code,
+// weighting digits alternately by 1 and 3 from the left.
+func ean13CheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte((10 - sum%10) % 10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validateProductDetails checks the optional fields shared by single and batch product requests.
+func validateProductDetails(barcode dto.OptString, quantity dto.OptInt, weight dto.OptFloat64) error {
+	if barcode.Set {
+		if err := validateBarcode(barcode.Value); err != nil {
+			return err
+		}
+	}
+	if quantity.Set && quantity.Value < 1 {
+		return ErrQuantityIsNotValid
+	}
+	if weight.Set && weight.Value <= 0 {
+		return ErrWeightIsNotValid
+	}
+	return nil
+}
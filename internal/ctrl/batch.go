@@ -0,0 +1,99 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	metrics "github.com/JMURv/avito-spring/internal/observability/metrics/prometheus"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"go.uber.org/zap"
+	"time"
+)
+
+// MaxBatchSize limits the number of products accepted in a single batch.
+const MaxBatchSize = 500
+
+// maxScanClockSkew tolerates device clocks running slightly ahead of the server.
+const maxScanClockSkew = time.Minute
+
+// AddItemsToReception validates every item on its own and adds the valid ones to the active reception
+// in one transaction. Items that fail validation are reported in their result and do not fail the batch.
+func (c *Controller) AddItemsToReception(ctx context.Context, req *dto.ProductsBatchPostReq) (*dto.ProductBatch, error) {
+	if len(req.Items) == 0 || len(req.Items) > MaxBatchSize {
+		return nil, ErrInvalidBatchSize
+	}
+
+	if err := c.checkScope(ctx, req.PvzId); err != nil {
+		return nil, err
+	}
+
+	res := &dto.ProductBatch{
+		Results: make([]dto.ProductBatchResult, len(req.Items)),
+	}
+
+	idx := make([]int, 0, len(req.Items))
+	items := make([]dto.ProductBatchItem, 0, len(req.Items))
+	for i := 0; i < len(req.Items); i++ {
+		res.Results[i].Index = i
+		if err := validateBatchItem(&req.Items[i]); err != nil {
+			res.Results[i].Error = dto.NewOptString(err.Error())
+			continue
+		}
+
+		idx = append(idx, i)
+		items = append(items, req.Items[i])
+	}
+
+	products, errs, err := c.repo.AddItemsToReception(ctx, req.PvzId, items, uidFromCtx(ctx))
+	if err != nil {
+		if errors.Is(err, repo.ErrNoActiveReception) {
+			zap.L().Debug("No active reception", zap.String("id", req.PvzId.String()))
+			return nil, ErrNoActiveReception
+		}
+		zap.L().Error(
+			"Failed to add items to reception",
+			zap.String("id", req.PvzId.String()),
+			zap.Int("items", len(items)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	for i := 0; i < len(products); i++ {
+		if errors.Is(errs[i], repo.ErrAlreadyExists) {
+			res.Results[idx[i]].Error = dto.NewOptString(ErrBarcodeAlreadyExists.Error())
+			continue
+		}
+		if products[i] == nil {
+			res.Results[idx[i]].Error = dto.NewOptString(ErrTypeIsNotValid.Error())
+			continue
+		}
+
+		res.Added++
+		res.Results[idx[i]].Product = dto.NewOptProduct(*products[i])
+		c.publish(
+			ctx, &md.Event{
+				Type:        md.EventProductAdded,
+				PVZID:       req.PvzId,
+				ReceptionID: products[i].ReceptionId,
+				ProductID:   products[i].ID.Value,
+				ProductType: products[i].Type,
+			},
+		)
+	}
+
+	res.Failed = int64(len(req.Items)) - res.Added
+	metrics.AddedProducts.Add(float64(res.Added))
+	return res, nil
+}
+
+func validateBatchItem(item *dto.ProductBatchItem) error {
+	if item.Type == "" {
+		return ErrTypeIsNotValid
+	}
+	if item.ScannedAt.Set && item.ScannedAt.Value.After(time.Now().Add(maxScanClockSkew)) {
+		return ErrScanTimeInFuture
+	}
+	return validateProductDetails(item.Barcode, item.Quantity, item.Weight)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/JMURv/avito-spring/internal/auth"
+	"github.com/JMURv/avito-spring/internal/broker"
 	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	md "github.com/JMURv/avito-spring/internal/models"
 	metrics "github.com/JMURv/avito-spring/internal/observability/metrics/prometheus"
@@ -16,39 +17,131 @@ import (
 type AppRepo interface {
 	GetUserByEmail(ctx context.Context, email string) (*md.User, error)
 	CreateUser(ctx context.Context, req *dto.RegisterPostReq) (uuid.UUID, error)
+	CreateRefreshToken(ctx context.Context, uid uuid.UUID, role, hash string, expiresAt time.Time) error
+	RotateRefreshToken(ctx context.Context, hash, newHash string, expiresAt time.Time) (*md.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, hash string, uid uuid.UUID) error
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
 	CreatePVZ(ctx context.Context, req *dto.PVZ) (uuid.UUID, time.Time, error)
-	GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error)
-	CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error)
-	DeleteLastProduct(ctx context.Context, id uuid.UUID) error
-	CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error)
-	AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error)
-
-	GetPVZList(ctx context.Context) ([]*md.PVZ, error)
+	GetPVZ(ctx context.Context, page, limit int64, after *md.PVZCursor, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, error)
+	CloseLastReception(ctx context.Context, id, uid uuid.UUID, check repo.ManifestCheck) (*dto.Reception, error)
+	CloseIdleReceptions(ctx context.Context, idleFor time.Duration, reason string, limit int) ([]*md.Reception, error)
+	ReopenReception(ctx context.Context, id, uid uuid.UUID, reason string, window time.Duration) (*dto.Reception, error)
+	GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error)
+	DeleteLastProduct(ctx context.Context, id, uid uuid.UUID) (*dto.Product, error)
+	UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error)
+	GetReception(ctx context.Context, id uuid.UUID) (*md.Reception, error)
+	DeleteProduct(ctx context.Context, receptionID, productID, uid uuid.UUID) error
+	CreateReception(ctx context.Context, req *dto.ReceptionsPostReq, uid uuid.UUID) (*dto.Reception, error)
+	AddItemToReception(ctx context.Context, req *dto.ProductsPostReq, uid uuid.UUID) (*dto.Product, error)
+	AddItemsToReception(ctx context.Context, pvzID uuid.UUID, items []dto.ProductBatchItem, uid uuid.UUID) ([]*dto.Product, []error, error)
+	FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error)
+
+	ReplaceManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem, uid uuid.UUID) (*dto.Manifest, error)
+	GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error)
+	GetReceptionProductCounts(ctx context.Context, receptionID uuid.UUID) ([]*md.ProductCount, error)
+
+	GetPVZList(ctx context.Context, after *md.PVZCursor, limit int64) ([]*md.PVZ, error)
+	GetPVZCity(ctx context.Context, id uuid.UUID) (string, error)
+	GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error)
+	UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error)
+	DecommissionPVZ(ctx context.Context, id, uid uuid.UUID) (*dto.PVZ, error)
+	FindNearbyPVZs(ctx context.Context, lat, lon, radius float64, after *md.NearbyCursor, limit int64) ([]*dto.NearbyPVZ, error)
+
+	ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error)
+	CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error)
+	SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error)
+	DeleteCity(ctx context.Context, name string) error
+	ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error)
+	CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error)
+	SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error)
+	DeleteProductType(ctx context.Context, name string) error
+
+	ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error)
+	AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error)
+	UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error
+	IsAssigned(ctx context.Context, uid, pvzID uuid.UUID) (bool, error)
+
+	ListAudit(ctx context.Context, filter *md.AuditFilter) ([]*dto.AuditEntry, error)
+
+	ClaimIdempotencyKey(ctx context.Context, k *md.IdempotencyKey) (bool, error)
+	GetIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) (*md.IdempotencyKey, error)
+	CompleteIdempotencyKey(ctx context.Context, k *md.IdempotencyKey) error
+	ReleaseIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) error
 }
 
 type AppCtrl interface {
 	DummyLogin(ctx context.Context, req *dto.DummyLoginPostReq) (dto.Token, error)
-	Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, error)
+	Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, string, error)
+	Refresh(ctx context.Context, refreshToken string) (dto.Token, string, error)
+	Logout(ctx context.Context, refreshToken string) error
 	Register(ctx context.Context, req *dto.RegisterPostReq) (*dto.User, error)
-	GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error)
+	GetPVZ(ctx context.Context, page, limit int64, cursor string, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, string, error)
 	CreatePVZ(ctx context.Context, req *dto.PVZ) (*dto.PVZ, error)
 	CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error)
+	ReopenReception(ctx context.Context, id uuid.UUID, reason string) (*dto.Reception, error)
+	GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error)
 	DeleteLastProduct(ctx context.Context, id uuid.UUID) error
+	UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error)
+	DeleteProduct(ctx context.Context, receptionID, productID uuid.UUID) error
 	CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error)
 	AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error)
-
-	GetPVZList(ctx context.Context) ([]*md.PVZ, error)
+	AddItemsToReception(ctx context.Context, req *dto.ProductsBatchPostReq) (*dto.ProductBatch, error)
+	FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error)
+
+	UploadManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem) (*dto.Manifest, error)
+	GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error)
+	GetDiscrepancies(ctx context.Context, receptionID uuid.UUID) (*dto.DiscrepancyReport, error)
+
+	GetPVZList(ctx context.Context, cursor string, limit int64) ([]*md.PVZ, string, error)
+	GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error)
+	UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error)
+	DecommissionPVZ(ctx context.Context, id uuid.UUID) (*dto.PVZ, error)
+	FindNearbyPVZ(ctx context.Context, lat, lon, radius float64, openNow bool, limit int64) ([]*dto.NearbyPVZ, error)
+	WatchPVZ(ctx context.Context, pvzID uuid.UUID, city string) (<-chan *md.Event, func())
+
+	ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error)
+	CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error)
+	SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error)
+	DeleteCity(ctx context.Context, name string) error
+	ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error)
+	CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error)
+	SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error)
+	DeleteProductType(ctx context.Context, name string) error
+
+	ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error)
+	AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error)
+	UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error
+
+	ListAudit(ctx context.Context, filter *md.AuditFilter) ([]*dto.AuditEntry, error)
+
+	BeginIdempotent(ctx context.Context, k *md.IdempotencyKey) (*md.IdempotencyKey, error)
+	CompleteIdempotent(ctx context.Context, k *md.IdempotencyKey) error
+	ReleaseIdempotent(ctx context.Context, k *md.IdempotencyKey) error
 }
 
+// DefaultReopenWindow is how long after closing a reception can be reopened unless configured otherwise.
+const DefaultReopenWindow = 24 * time.Hour
+
 type Controller struct {
-	repo AppRepo
-	au   auth.Core
+	repo         AppRepo
+	au           auth.Core
+	events       *broker.Broker
+	reopenWindow time.Duration
 }
 
 func New(repo AppRepo, au auth.Core) *Controller {
 	return &Controller{
-		repo: repo,
-		au:   au,
+		repo:         repo,
+		au:           au,
+		events:       broker.New(),
+		reopenWindow: DefaultReopenWindow,
+	}
+}
+
+// SetReopenWindow changes how long after closing a reception can be reopened, non-positive values are ignored.
+func (c *Controller) SetReopenWindow(d time.Duration) {
+	if d > 0 {
+		c.reopenWindow = d
 	}
 }
 
@@ -61,33 +154,100 @@ func (c *Controller) DummyLogin(_ context.Context, req *dto.DummyLoginPostReq) (
 	return dto.Token(token), nil
 }
 
-func (c *Controller) Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, error) {
+// Login returns an access token together with a refresh token for it.
+func (c *Controller) Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, string, error) {
 	usr, err := c.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, repo.ErrNotFound) {
 			zap.L().Debug("User not found", zap.String("email", req.Email))
-			return "", auth.ErrInvalidCredentials
+			return "", "", auth.ErrInvalidCredentials
 		}
 		zap.L().Error("Failed to get user by email", zap.Error(err))
-		return "", err
+		return "", "", err
 	}
 
 	err = c.au.ComparePasswords([]byte(usr.Password), []byte(req.Password))
 	if err != nil {
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			zap.L().Debug("Password mismatch", zap.String("email", req.Email))
-			return "", auth.ErrInvalidCredentials
+			return "", "", auth.ErrInvalidCredentials
 		}
 		zap.L().Error("Failed to compare passwords", zap.Error(err))
-		return "", err
+		return "", "", err
 	}
 
 	token, err := c.au.NewToken(usr.ID, usr.Role)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	return dto.Token(token), nil
+	refresh, err := c.au.NewRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	err = c.repo.CreateRefreshToken(ctx, usr.ID, usr.Role, c.au.HashToken(refresh), time.Now().Add(auth.RefreshTokenDuration))
+	if err != nil {
+		zap.L().Error("Failed to store refresh token", zap.String("uid", usr.ID.String()), zap.Error(err))
+		return "", "", err
+	}
+
+	return dto.Token(token), refresh, nil
+}
+
+// Refresh exchanges a refresh token for a new access token and a rotated refresh token.
+func (c *Controller) Refresh(ctx context.Context, refreshToken string) (dto.Token, string, error) {
+	refresh, err := c.au.NewRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	res, err := c.repo.RotateRefreshToken(
+		ctx,
+		c.au.HashToken(refreshToken),
+		c.au.HashToken(refresh),
+		time.Now().Add(auth.RefreshTokenDuration),
+	)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Refresh token is unknown, expired or revoked")
+			return "", "", auth.ErrInvalidRefreshToken
+		}
+		zap.L().Error("Failed to rotate refresh token", zap.Error(err))
+		return "", "", err
+	}
+
+	token, err := c.au.NewToken(res.UserID, res.Role)
+	if err != nil {
+		return "", "", err
+	}
+
+	return dto.Token(token), refresh, nil
+}
+
+// Logout revokes the access token the request was made with and, when given, the refresh token.
+func (c *Controller) Logout(ctx context.Context, refreshToken string) error {
+	uid := uidFromCtx(ctx)
+	if refreshToken != "" {
+		if err := c.repo.RevokeRefreshToken(ctx, c.au.HashToken(refreshToken), uid); err != nil {
+			zap.L().Error("Failed to revoke refresh token", zap.String("uid", uid.String()), zap.Error(err))
+			return err
+		}
+	}
+
+	jti, _ := ctx.Value("jti").(string)
+	if jti == "" {
+		return nil
+	}
+
+	until := time.Now().Add(auth.TokenDuration)
+	if err := c.repo.RevokeToken(ctx, jti, until); err != nil {
+		zap.L().Error("Failed to revoke token", zap.String("jti", jti), zap.Error(err))
+		return err
+	}
+
+	c.au.MarkRevoked(jti, until)
+	return nil
 }
 
 func (c *Controller) Register(ctx context.Context, req *dto.RegisterPostReq) (*dto.User, error) {
@@ -115,19 +275,41 @@ func (c *Controller) Register(ctx context.Context, req *dto.RegisterPostReq) (*d
 	}, nil
 }
 
-func (c *Controller) GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error) {
-	res, err := c.repo.GetPVZ(ctx, page, limit, startDate, endDate)
+// GetPVZ pages either by page/limit or, when cursor is set, by keyset. The returned
+// cursor points past the last PVZ of a full page and is empty on the last page.
+func (c *Controller) GetPVZ(ctx context.Context, page, limit int64, cursor string, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, string, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		zap.L().Debug("Invalid cursor", zap.String("cursor", cursor))
+		return nil, "", err
+	}
+
+	res, err := c.repo.GetPVZ(ctx, page, limit, after, startDate, endDate, includeDeleted)
 	if err != nil {
 		zap.L().Error("Failed to get PVZ", zap.Error(err))
-		return nil, err
+		return nil, "", err
 	}
 
-	return res, nil
+	var next string
+	if int64(len(res)) == limit {
+		last := res[len(res)-1].Pvz.Value
+		next = encodeCursor(last.RegistrationDate.Value, last.ID.Value)
+	}
+
+	return res, next, nil
 }
 
 func (c *Controller) CreatePVZ(ctx context.Context, req *dto.PVZ) (*dto.PVZ, error) {
+	if err := validatePVZ(req); err != nil {
+		return nil, err
+	}
+
 	id, createdAt, err := c.repo.CreatePVZ(ctx, req)
 	if err != nil {
+		if errors.Is(err, repo.ErrCityIsNotValid) {
+			zap.L().Debug("City is not valid", zap.String("city", req.City))
+			return nil, ErrCityIsNotValid
+		}
 		zap.L().Error("Failed to create PVZ", zap.Error(err))
 		return nil, err
 	}
@@ -142,26 +324,120 @@ func (c *Controller) CreatePVZ(ctx context.Context, req *dto.PVZ) (*dto.PVZ, err
 			Value: createdAt,
 			Set:   true,
 		},
-		City: req.City,
+		City:         req.City,
+		Name:         req.Name,
+		Address:      req.Address,
+		WorkingHours: req.WorkingHours,
+		Timezone:     req.Timezone,
+		Coordinates:  req.Coordinates,
+		Capacity:     req.Capacity,
+		Status:       dto.NewOptPVZStatus(dto.PVZStatusActive),
 	}, nil
 }
 
+// CloseLastReception closes the active reception of the PVZ and attaches its summary and, when a manifest
+// was uploaded, the discrepancy report. A reception with a strict manifest is closed only once it matches.
 func (c *Controller) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error) {
-	res, err := c.repo.CloseLastReception(ctx, id)
+	if err := c.checkScope(ctx, id); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.CloseLastReception(ctx, id, uidFromCtx(ctx), checkStrictManifest)
 	if err != nil {
 		if errors.Is(err, repo.ErrReceptionAlreadyClosed) {
 			zap.L().Debug("Reception already closed", zap.String("id", id.String()))
 			return nil, ErrReceptionAlreadyClosed
 		}
+		if errors.Is(err, ErrManifestDiscrepancies) {
+			zap.L().Debug("Reception does not match its strict manifest", zap.String("id", id.String()))
+			return nil, err
+		}
 		zap.L().Error("Failed to close last reception", zap.String("id", id.String()), zap.Error(err))
 		return nil, err
 	}
 
+	summary, err := c.repo.GetReceptionSummary(ctx, res.ID.Value)
+	if err != nil {
+		zap.L().Warn("Failed to build reception summary", zap.String("id", res.ID.Value.String()), zap.Error(err))
+	} else {
+		res.Summary = dto.NewOptReceptionSummary(*summary)
+	}
+
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventReceptionClosed,
+			PVZID:       id,
+			ReceptionID: res.ID.Value,
+		},
+	)
+	return res, nil
+}
+
+// ReopenReception moves a recently closed reception back to in progress. It is refused when the PVZ
+// already has a newer reception or the reception was closed longer than the reopen window ago.
+func (c *Controller) ReopenReception(ctx context.Context, id uuid.UUID, reason string) (*dto.Reception, error) {
+	res, err := c.repo.ReopenReception(ctx, id, uidFromCtx(ctx), reason, c.reopenWindow)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Reception not found", zap.String("id", id.String()))
+			return nil, ErrReceptionNotFound
+		}
+		if errors.Is(err, repo.ErrReceptionStillOpen) {
+			zap.L().Debug("Reception still open", zap.String("id", id.String()))
+			return nil, ErrReceptionStillOpen
+		}
+		if errors.Is(err, repo.ErrPVZClosed) {
+			zap.L().Debug("PVZ is closed", zap.String("id", id.String()))
+			return nil, ErrPVZClosed
+		}
+		if errors.Is(err, repo.ErrNewerReceptionExists) {
+			zap.L().Debug("Newer reception exists", zap.String("id", id.String()))
+			return nil, ErrNewerReceptionExists
+		}
+		if errors.Is(err, repo.ErrReopenWindowExpired) {
+			zap.L().Debug("Reopen window expired", zap.String("id", id.String()))
+			return nil, ErrReopenWindowExpired
+		}
+		zap.L().Error("Failed to reopen reception", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info(
+		"Reception reopened",
+		zap.String("id", id.String()),
+		zap.String("reason", reason),
+		zap.String("uid", uidFromCtx(ctx).String()),
+	)
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventReceptionOpened,
+			PVZID:       res.PvzId,
+			ReceptionID: id,
+		},
+	)
+	return res, nil
+}
+
+func (c *Controller) GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error) {
+	res, err := c.repo.GetReceptionSummary(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Reception not found", zap.String("id", id.String()))
+			return nil, ErrReceptionNotFound
+		}
+		zap.L().Error("Failed to get reception summary", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
 	return res, nil
 }
 
 func (c *Controller) DeleteLastProduct(ctx context.Context, id uuid.UUID) error {
-	err := c.repo.DeleteLastProduct(ctx, id)
+	if err := c.checkScope(ctx, id); err != nil {
+		return err
+	}
+
+	res, err := c.repo.DeleteLastProduct(ctx, id, uidFromCtx(ctx))
 	if err != nil {
 		if errors.Is(err, repo.ErrNoActiveReception) {
 			zap.L().Debug("No active reception", zap.String("id", id.String()))
@@ -175,33 +451,175 @@ func (c *Controller) DeleteLastProduct(ctx context.Context, id uuid.UUID) error
 		return err
 	}
 
+	zap.L().Info(
+		"Last product deleted",
+		zap.String("id", id.String()),
+		zap.String("product", res.ID.Value.String()),
+		zap.String("uid", uidFromCtx(ctx).String()),
+	)
+
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventProductDeleted,
+			PVZID:       id,
+			ReceptionID: res.ReceptionId,
+			ProductID:   res.ID.Value,
+		},
+	)
+	return nil
+}
+
+// UndoLastDelete restores the most recently deleted product of the PVZ's reception while it is still in progress.
+func (c *Controller) UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error) {
+	if err := c.checkScope(ctx, id); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.UndoLastDelete(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNoActiveReception) {
+			zap.L().Debug("No active reception", zap.String("id", id.String()))
+			return nil, ErrNoActiveReception
+		}
+		if errors.Is(err, repo.ErrNoDeletedItems) {
+			zap.L().Debug("No deleted items to restore", zap.String("id", id.String()))
+			return nil, ErrNoDeletedItems
+		}
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			zap.L().Debug("Barcode of the deleted product was scanned again", zap.String("id", id.String()))
+			return nil, ErrBarcodeAlreadyExists
+		}
+		zap.L().Error("Failed to undo last delete", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info(
+		"Last deleted product restored",
+		zap.String("id", id.String()),
+		zap.String("product", res.ID.Value.String()),
+		zap.String("uid", uidFromCtx(ctx).String()),
+	)
+
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventProductRestored,
+			PVZID:       id,
+			ReceptionID: res.ReceptionId,
+			ProductID:   res.ID.Value,
+			ProductType: res.Type,
+		},
+	)
+	return res, nil
+}
+
+// DeleteProduct deletes a single product of the reception, which must still be in progress.
+func (c *Controller) DeleteProduct(ctx context.Context, receptionID, productID uuid.UUID) error {
+	reception, err := c.repo.GetReception(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Reception not found", zap.String("id", receptionID.String()))
+			return ErrReceptionNotFound
+		}
+		zap.L().Error("Failed to get reception", zap.String("id", receptionID.String()), zap.Error(err))
+		return err
+	}
+
+	if err = c.checkScope(ctx, reception.PVZID); err != nil {
+		return err
+	}
+
+	err = c.repo.DeleteProduct(ctx, receptionID, productID, uidFromCtx(ctx))
+	if err != nil {
+		if errors.Is(err, repo.ErrNoActiveReception) {
+			zap.L().Debug("Reception is not in progress", zap.String("id", receptionID.String()))
+			return ErrNoActiveReception
+		}
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug(
+				"Product not found",
+				zap.String("id", receptionID.String()),
+				zap.String("product", productID.String()),
+			)
+			return ErrProductNotFound
+		}
+		zap.L().Error(
+			"Failed to delete product",
+			zap.String("id", receptionID.String()),
+			zap.String("product", productID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	zap.L().Info(
+		"Product deleted",
+		zap.String("id", receptionID.String()),
+		zap.String("product", productID.String()),
+		zap.String("uid", uidFromCtx(ctx).String()),
+	)
+
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventProductDeleted,
+			PVZID:       reception.PVZID,
+			ReceptionID: receptionID,
+			ProductID:   productID,
+		},
+	)
 	return nil
 }
 
 func (c *Controller) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error) {
-	res, err := c.repo.CreateReception(ctx, req)
+	if err := c.checkScope(ctx, req.PvzId); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.CreateReception(ctx, req, uidFromCtx(ctx))
 	if err != nil {
 		if errors.Is(err, repo.ErrReceptionStillOpen) {
 			zap.L().Debug("Reception still open", zap.String("uid", req.PvzId.String()))
 			return nil, ErrReceptionStillOpen
 		}
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("PVZ not found", zap.String("id", req.PvzId.String()))
+			return nil, ErrPVZNotFound
+		}
+		if errors.Is(err, repo.ErrPVZClosed) {
+			zap.L().Debug("PVZ is closed", zap.String("id", req.PvzId.String()))
+			return nil, ErrPVZClosed
+		}
 
 		zap.L().Error("Failed to create reception", zap.String("uid", req.PvzId.String()), zap.Error(err))
 		return nil, err
 	}
 
 	metrics.CreatedOrderReceipts.Inc()
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventReceptionOpened,
+			PVZID:       req.PvzId,
+			ReceptionID: res.ID.Value,
+		},
+	)
 	return res, nil
 }
 
 func (c *Controller) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error) {
-	res, err := c.repo.AddItemToReception(ctx, req)
+	if err := validateProductDetails(req.Barcode, req.Quantity, req.Weight); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkScope(ctx, req.PvzId); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.AddItemToReception(ctx, req, uidFromCtx(ctx))
 	if err != nil {
 		if errors.Is(err, repo.ErrNoActiveReception) {
 			zap.L().Debug(
 				"No active reception",
 				zap.String("uid", req.PvzId.String()),
-				zap.String("type", string(req.Type)),
+				zap.String("type", req.Type),
 			)
 			return nil, ErrNoActiveReception
 		}
@@ -210,30 +628,109 @@ func (c *Controller) AddItemToReception(ctx context.Context, req *dto.ProductsPo
 			zap.L().Debug(
 				"Type is not valid",
 				zap.String("uid", req.PvzId.String()),
-				zap.String("type", string(req.Type)),
+				zap.String("type", req.Type),
 			)
 			return nil, ErrTypeIsNotValid
 		}
 
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			zap.L().Debug(
+				"Barcode is already in the reception",
+				zap.String("uid", req.PvzId.String()),
+				zap.String("barcode", req.Barcode.Value),
+			)
+			return nil, ErrBarcodeAlreadyExists
+		}
+
 		zap.L().Error(
 			"Failed to create reception",
 			zap.String("uid", req.PvzId.String()),
-			zap.String("type", string(req.Type)),
+			zap.String("type", req.Type),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
 	metrics.AddedProducts.Inc()
+	c.publish(
+		ctx, &md.Event{
+			Type:        md.EventProductAdded,
+			PVZID:       req.PvzId,
+			ReceptionID: res.ReceptionId,
+			ProductID:   res.ID.Value,
+			ProductType: res.Type,
+		},
+	)
 	return res, nil
 }
 
-func (c *Controller) GetPVZList(ctx context.Context) ([]*md.PVZ, error) {
-	res, err := c.repo.GetPVZList(ctx)
+// GetPVZList returns every PVZ when limit is zero, otherwise a keyset page after cursor.
+func (c *Controller) GetPVZList(ctx context.Context, cursor string, limit int64) ([]*md.PVZ, string, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		zap.L().Debug("Invalid cursor", zap.String("cursor", cursor))
+		return nil, "", err
+	}
+
+	res, err := c.repo.GetPVZList(ctx, after, limit)
 	if err != nil {
 		zap.L().Error("Failed to get pvzs list", zap.Error(err))
+		return nil, "", err
+	}
+
+	var next string
+	if limit > 0 && int64(len(res)) == limit {
+		last := res[len(res)-1]
+		next = encodeCursor(last.RegistrationDate, last.ID)
+	}
+
+	return res, next, nil
+}
+
+func (c *Controller) WatchPVZ(_ context.Context, pvzID uuid.UUID, city string) (<-chan *md.Event, func()) {
+	return c.events.Subscribe(
+		broker.Filter{
+			PVZID: pvzID,
+			City:  city,
+		},
+	)
+}
+
+// publish resolves the PVZ city and hands the event to the broker.
+// The lookup is skipped entirely while nobody is watching.
+func (c *Controller) publish(ctx context.Context, e *md.Event) {
+	if !c.events.HasSubscribers() {
+		return
+	}
+
+	city, err := c.repo.GetPVZCity(ctx, e.PVZID)
+	if err != nil {
+		zap.L().Warn("Failed to resolve PVZ city for event", zap.String("id", e.PVZID.String()), zap.Error(err))
+	}
+
+	e.City = city
+	e.UserID = uidFromCtx(ctx)
+	e.CreatedAt = time.Now()
+	c.events.Publish(e)
+}
+
+// FindProductsByBarcode returns the products with the barcode from all receptions.
+func (c *Controller) FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error) {
+	if err := validateBarcode(barcode); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.FindProductsByBarcode(ctx, barcode)
+	if err != nil {
+		zap.L().Error("Failed to find products by barcode", zap.String("barcode", barcode), zap.Error(err))
 		return nil, err
 	}
 
 	return res, nil
 }
+
+// uidFromCtx returns the id of the authenticated user put into the context by the auth middleware.
+func uidFromCtx(ctx context.Context) uuid.UUID {
+	uid, _ := ctx.Value("uid").(uuid.UUID)
+	return uid
+}
@@ -2,7 +2,9 @@ package ctrl
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/JMURv/avito-spring/internal/auth"
 	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	md "github.com/JMURv/avito-spring/internal/models"
@@ -11,6 +13,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -84,7 +88,7 @@ func TestController_Login(t *testing.T) {
 		name       string
 		req        *dto.LoginPostReq
 		expect     func()
-		assertions func(dto.Token, error)
+		assertions func(dto.Token, string, error)
 	}{
 		{
 			name: "User not found",
@@ -95,7 +99,7 @@ func TestController_Login(t *testing.T) {
 			expect: func() {
 				repoMock.EXPECT().GetUserByEmail(ctx, "notfound@example.com").Return(nil, repo.ErrNotFound)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.Empty(t, res)
 				assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
 			},
@@ -109,7 +113,7 @@ func TestController_Login(t *testing.T) {
 			expect: func() {
 				repoMock.EXPECT().GetUserByEmail(ctx, "error@example.com").Return(nil, testErr)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.Empty(t, res)
 				assert.Equal(t, testErr, err)
 			},
@@ -131,7 +135,7 @@ func TestController_Login(t *testing.T) {
 					[]byte("wrongpass"),
 				).Return(auth.ErrInvalidCredentials)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.Empty(t, res)
 				assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
 			},
@@ -150,7 +154,7 @@ func TestController_Login(t *testing.T) {
 				)
 				authMock.EXPECT().ComparePasswords([]byte("hashed"), []byte("pass")).Return(testErr)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.Empty(t, res)
 				assert.Equal(t, testErr, err)
 			},
@@ -172,7 +176,7 @@ func TestController_Login(t *testing.T) {
 				authMock.EXPECT().ComparePasswords([]byte("hashedpass"), []byte("correctpass")).Return(nil)
 				authMock.EXPECT().NewToken(gomock.Any(), "user").Return("", testErr)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.Empty(t, res)
 				assert.Equal(t, testErr, err)
 			},
@@ -193,10 +197,40 @@ func TestController_Login(t *testing.T) {
 				)
 				authMock.EXPECT().ComparePasswords([]byte("hashedcorrect"), []byte("correctpass")).Return(nil)
 				authMock.EXPECT().NewToken(gomock.Any(), "admin").Return("valid-token", nil)
+				authMock.EXPECT().NewRefreshToken().Return("refresh-token", nil)
+				authMock.EXPECT().HashToken("refresh-token").Return("refresh-hash")
+				repoMock.EXPECT().CreateRefreshToken(ctx, gomock.Any(), "admin", "refresh-hash", gomock.Any()).Return(nil)
 			},
-			assertions: func(res dto.Token, err error) {
+			assertions: func(res dto.Token, refresh string, err error) {
 				assert.NoError(t, err)
 				assert.Equal(t, dto.Token("valid-token"), res)
+				assert.Equal(t, "refresh-token", refresh)
+			},
+		},
+		{
+			name: "CreateRefreshToken error",
+			req: &dto.LoginPostReq{
+				Email:    "success@example.com",
+				Password: "correctpass",
+			},
+			expect: func() {
+				repoMock.EXPECT().GetUserByEmail(ctx, "success@example.com").Return(
+					&md.User{
+						ID:       uuid.New(),
+						Password: "hashedcorrect",
+						Role:     "admin",
+					}, nil,
+				)
+				authMock.EXPECT().ComparePasswords([]byte("hashedcorrect"), []byte("correctpass")).Return(nil)
+				authMock.EXPECT().NewToken(gomock.Any(), "admin").Return("valid-token", nil)
+				authMock.EXPECT().NewRefreshToken().Return("refresh-token", nil)
+				authMock.EXPECT().HashToken("refresh-token").Return("refresh-hash")
+				repoMock.EXPECT().CreateRefreshToken(ctx, gomock.Any(), "admin", "refresh-hash", gomock.Any()).Return(testErr)
+			},
+			assertions: func(res dto.Token, refresh string, err error) {
+				assert.Empty(t, res)
+				assert.Empty(t, refresh)
+				assert.ErrorIs(t, err, testErr)
 			},
 		},
 	}
@@ -207,8 +241,8 @@ func TestController_Login(t *testing.T) {
 				if tt.expect != nil {
 					tt.expect()
 				}
-				res, err := ctrl.Login(ctx, tt.req)
-				tt.assertions(res, err)
+				res, refresh, err := ctrl.Login(ctx, tt.req)
+				tt.assertions(res, refresh, err)
 			},
 		)
 	}
@@ -327,33 +361,77 @@ func TestController_GetPVZ(t *testing.T) {
 		},
 	}
 
+	lastID := uuid.New()
+	lastDate := time.Date(2025, 4, 1, 12, 0, 0, 123000, time.UTC)
+	fullPage := []*dto.PvzGetOKItem{
+		{
+			Pvz: dto.OptPVZ{
+				Set: true,
+				Value: dto.PVZ{
+					ID:               dto.OptUUID{Set: true, Value: lastID},
+					RegistrationDate: dto.OptDateTime{Set: true, Value: lastDate},
+				},
+			},
+		},
+	}
+	cursor := encodeCursor(lastDate, lastID)
+
 	tests := []struct {
 		name       string
+		limit      int64
+		cursor     string
 		expect     func()
-		assertions func(res []*dto.PvzGetOKItem, err error)
+		assertions func(res []*dto.PvzGetOKItem, next string, err error)
 	}{
 		{
-			name: "GetPVZ returns error",
+			name:   "Invalid cursor",
+			limit:  limit,
+			cursor: "not a cursor",
+			expect: func() {},
+			assertions: func(res []*dto.PvzGetOKItem, next string, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, ErrInvalidCursor)
+			},
+		},
+		{
+			name:  "GetPVZ returns error",
+			limit: limit,
 			expect: func() {
 				repoMock.EXPECT().
-					GetPVZ(ctx, page, limit, startDate, endDate).
+					GetPVZ(ctx, page, limit, nil, startDate, endDate, false).
 					Return(nil, testErr)
 			},
-			assertions: func(res []*dto.PvzGetOKItem, err error) {
+			assertions: func(res []*dto.PvzGetOKItem, next string, err error) {
 				assert.Nil(t, res)
 				assert.ErrorIs(t, err, testErr)
 			},
 		},
 		{
-			name: "Successful GetPVZ",
+			name:  "Successful GetPVZ",
+			limit: limit,
 			expect: func() {
 				repoMock.EXPECT().
-					GetPVZ(ctx, page, limit, startDate, endDate).
+					GetPVZ(ctx, page, limit, nil, startDate, endDate, false).
 					Return(sampleResponse, nil)
 			},
-			assertions: func(res []*dto.PvzGetOKItem, err error) {
+			assertions: func(res []*dto.PvzGetOKItem, next string, err error) {
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
+				assert.Empty(t, next)
+			},
+		},
+		{
+			name:   "Full page returns next cursor",
+			limit:  1,
+			cursor: cursor,
+			expect: func() {
+				repoMock.EXPECT().
+					GetPVZ(ctx, page, int64(1), &md.PVZCursor{CreatedAt: lastDate, ID: lastID}, startDate, endDate, false).
+					Return(fullPage, nil)
+			},
+			assertions: func(res []*dto.PvzGetOKItem, next string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, cursor, next)
 			},
 		},
 	}
@@ -362,8 +440,8 @@ func TestController_GetPVZ(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.expect()
-				res, err := ctrl.GetPVZ(ctx, page, limit, startDate, endDate)
-				tt.assertions(res, err)
+				res, next, err := ctrl.GetPVZ(ctx, page, tt.limit, tt.cursor, startDate, endDate, false)
+				tt.assertions(res, next, err)
 			},
 		)
 	}
@@ -401,7 +479,47 @@ func TestController_CreatePVZ(t *testing.T) {
 			},
 			assertions: func(resp *dto.PVZ, err error) {
 				assert.Nil(t, resp)
-				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, ErrCityIsNotValid)
+			},
+		},
+		{
+			name: "Working hours not valid error",
+			req: &dto.PVZ{
+				City: "TestCity",
+				WorkingHours: dto.WorkingHours{
+					{Day: dto.WorkingHoursItemDayMon, Open: "21:00", Close: "09:00"},
+				},
+			},
+			expect: func() {},
+			assertions: func(resp *dto.PVZ, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrWorkingHoursIsNotValid)
+			},
+		},
+		{
+			name: "Working hours without timezone error",
+			req: &dto.PVZ{
+				City: "TestCity",
+				WorkingHours: dto.WorkingHours{
+					{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"},
+				},
+			},
+			expect: func() {},
+			assertions: func(resp *dto.PVZ, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrTimezoneRequired)
+			},
+		},
+		{
+			name: "Coordinates not valid error",
+			req: &dto.PVZ{
+				City:        "TestCity",
+				Coordinates: dto.NewOptCoordinates(dto.Coordinates{Latitude: 91, Longitude: 37.6}),
+			},
+			expect: func() {},
+			assertions: func(resp *dto.PVZ, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrCoordinatesIsNotValid)
 			},
 		},
 		{
@@ -434,7 +552,30 @@ func TestController_CreatePVZ(t *testing.T) {
 				assert.NotNil(t, resp)
 				assert.Equal(t, testID, resp.ID.Value)
 				assert.Equal(t, createdAt, resp.RegistrationDate.Value)
-				assert.Equal(t, dto.PVZCity("TestCity"), resp.City)
+				assert.Equal(t, "TestCity", resp.City)
+				assert.Equal(t, dto.PVZStatusActive, resp.Status.Value)
+			},
+		},
+		{
+			name: "Successful creation with location",
+			req: &dto.PVZ{
+				City:         "TestCity",
+				Address:      dto.NewOptString("ул. Тверская, 1"),
+				WorkingHours: dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"}},
+				Timezone:     dto.NewOptString("Europe/Moscow"),
+				Coordinates:  dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7658, Longitude: 37.6049}),
+				Capacity:     dto.NewOptInt(500),
+			},
+			expect: func() {
+				repoMock.EXPECT().
+					CreatePVZ(ctx, gomock.Any()).
+					Return(testID, createdAt, nil)
+			},
+			assertions: func(resp *dto.PVZ, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Europe/Moscow", resp.Timezone.Value)
+				assert.Equal(t, 55.7658, resp.Coordinates.Value.Latitude)
+				assert.Equal(t, 500, resp.Capacity.Value)
 			},
 		},
 	}
@@ -473,6 +614,20 @@ func TestController_CloseLastReception(t *testing.T) {
 		Status:   "closed",
 	}
 
+	runCheck := func(m *dto.Manifest, counts []*md.ProductCount) func(
+		context.Context, uuid.UUID, uuid.UUID, repo.ManifestCheck,
+	) (*dto.Reception, error) {
+		return func(_ context.Context, _, _ uuid.UUID, check repo.ManifestCheck) (*dto.Reception, error) {
+			report, err := check(m, counts)
+			if err != nil {
+				return nil, err
+			}
+			res := *sampleReception
+			res.Discrepancies = dto.NewOptDiscrepancyReport(*report)
+			return &res, nil
+		}
+	}
+
 	tests := []struct {
 		name       string
 		id         uuid.UUID
@@ -484,7 +639,7 @@ func TestController_CloseLastReception(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					CloseLastReception(ctx, testID).
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
 					Return(nil, closedAlreadyErr)
 			},
 			assertions: func(res *dto.Reception, err error) {
@@ -497,7 +652,7 @@ func TestController_CloseLastReception(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					CloseLastReception(ctx, testID).
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
 					Return(nil, testErr)
 			},
 			assertions: func(res *dto.Reception, err error) {
@@ -505,18 +660,90 @@ func TestController_CloseLastReception(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "Summary error does not fail closing",
+			id:   testID,
+			expect: func() {
+				repoMock.EXPECT().
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
+					Return(&dto.Reception{ID: dto.OptUUID{Value: testID, Set: true}}, nil)
+				repoMock.EXPECT().
+					GetReceptionSummary(ctx, testID).
+					Return(nil, testErr)
+			},
+			assertions: func(res *dto.Reception, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+				assert.False(t, res.Summary.Set)
+				assert.False(t, res.Discrepancies.Set)
+			},
+		},
 		{
 			name: "Successful close of last reception",
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					CloseLastReception(ctx, testID).
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
 					Return(sampleReception, nil)
+				repoMock.EXPECT().
+					GetReceptionSummary(ctx, testID).
+					Return(&dto.ReceptionSummary{ReceptionId: testID, TotalProducts: 3}, nil)
 			},
 			assertions: func(res *dto.Reception, err error) {
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
 				assert.Equal(t, testID, res.ID.Value)
+				assert.True(t, res.Summary.Set)
+				assert.Equal(t, int64(3), res.Summary.Value.TotalProducts)
+				assert.False(t, res.Discrepancies.Set)
+			},
+		},
+		{
+			name: "Strict manifest with discrepancies",
+			id:   testID,
+			expect: func() {
+				repoMock.EXPECT().
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
+					DoAndReturn(
+						runCheck(
+							&dto.Manifest{
+								ReceptionId: testID,
+								Strict:      true,
+								Items:       []dto.ManifestItem{{Type: dto.NewOptString("обувь"), Quantity: 2}},
+							},
+							[]*md.ProductCount{{Type: "обувь", Quantity: 1}},
+						),
+					)
+			},
+			assertions: func(res *dto.Reception, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, ErrManifestDiscrepancies)
+			},
+		},
+		{
+			name: "Discrepancy report is attached",
+			id:   testID,
+			expect: func() {
+				repoMock.EXPECT().
+					CloseLastReception(ctx, testID, uuid.Nil, gomock.Any()).
+					DoAndReturn(
+						runCheck(
+							&dto.Manifest{
+								ReceptionId: testID,
+								Items:       []dto.ManifestItem{{Type: dto.NewOptString("обувь"), Quantity: 2}},
+							},
+							[]*md.ProductCount{{Type: "обувь", Quantity: 1}},
+						),
+					)
+				repoMock.EXPECT().
+					GetReceptionSummary(ctx, testID).
+					Return(&dto.ReceptionSummary{ReceptionId: testID, TotalProducts: 1}, nil)
+			},
+			assertions: func(res *dto.Reception, err error) {
+				assert.NoError(t, err)
+				assert.True(t, res.Discrepancies.Set)
+				assert.False(t, res.Discrepancies.Value.Reconciled)
+				assert.Len(t, res.Discrepancies.Value.Missing, 1)
 			},
 		},
 	}
@@ -532,6 +759,66 @@ func TestController_CloseLastReception(t *testing.T) {
 	}
 }
 
+func TestController_GetReceptionSummary(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	testErr := errors.New("test error")
+	testID := uuid.New()
+
+	tests := []struct {
+		name       string
+		expect     func()
+		assertions func(*dto.ReceptionSummary, error)
+	}{
+		{
+			name: "Not found",
+			expect: func() {
+				repoMock.EXPECT().GetReceptionSummary(ctx, testID).Return(nil, repo.ErrNotFound)
+			},
+			assertions: func(res *dto.ReceptionSummary, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, ErrReceptionNotFound)
+			},
+		},
+		{
+			name: "General error",
+			expect: func() {
+				repoMock.EXPECT().GetReceptionSummary(ctx, testID).Return(nil, testErr)
+			},
+			assertions: func(res *dto.ReceptionSummary, err error) {
+				assert.Nil(t, res)
+				assert.Equal(t, testErr, err)
+			},
+		},
+		{
+			name: "Success",
+			expect: func() {
+				repoMock.EXPECT().GetReceptionSummary(ctx, testID).Return(&dto.ReceptionSummary{ReceptionId: testID}, nil)
+			},
+			assertions: func(res *dto.ReceptionSummary, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, testID, res.ReceptionId)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := ctrl.GetReceptionSummary(ctx, testID)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
 func TestController_DeleteLastProduct(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -555,8 +842,8 @@ func TestController_DeleteLastProduct(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					DeleteLastProduct(ctx, testID).
-					Return(repo.ErrNoActiveReception)
+					DeleteLastProduct(ctx, testID, uuid.Nil).
+					Return(nil, repo.ErrNoActiveReception)
 			},
 			assertions: func(err error) {
 				assert.ErrorIs(t, err, ErrNoActiveReception)
@@ -567,8 +854,8 @@ func TestController_DeleteLastProduct(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					DeleteLastProduct(ctx, testID).
-					Return(repo.ErrNoItems)
+					DeleteLastProduct(ctx, testID, uuid.Nil).
+					Return(nil, repo.ErrNoItems)
 			},
 			assertions: func(err error) {
 				assert.ErrorIs(t, err, ErrNoItems)
@@ -579,8 +866,8 @@ func TestController_DeleteLastProduct(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					DeleteLastProduct(ctx, testID).
-					Return(testErr)
+					DeleteLastProduct(ctx, testID, uuid.Nil).
+					Return(nil, testErr)
 			},
 			assertions: func(err error) {
 				assert.Error(t, err)
@@ -592,8 +879,8 @@ func TestController_DeleteLastProduct(t *testing.T) {
 			id:   testID,
 			expect: func() {
 				repoMock.EXPECT().
-					DeleteLastProduct(ctx, testID).
-					Return(nil)
+					DeleteLastProduct(ctx, testID, uuid.Nil).
+					Return(&dto.Product{ID: dto.NewOptUUID(uuid.New()), ReceptionId: uuid.New()}, nil)
 			},
 			assertions: func(err error) {
 				assert.NoError(t, err)
@@ -612,6 +899,128 @@ func TestController_DeleteLastProduct(t *testing.T) {
 	}
 }
 
+func TestController_DeleteProduct(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	testErr := errors.New("test error")
+	ctx := context.WithValue(context.WithValue(context.Background(), "uid", uid), "role", md.EmployeeRole)
+	reception := &md.Reception{ID: receptionID, PVZID: pvzID, Status: "in_progress"}
+
+	tests := []struct {
+		name    string
+		expect  func()
+		wantErr error
+	}{
+		{
+			name: "ReceptionNotFound",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(nil, repo.ErrNotFound)
+			},
+			wantErr: ErrReceptionNotFound,
+		},
+		{
+			name: "NotAssigned",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+				repoMock.EXPECT().IsAssigned(ctx, uid, pvzID).Return(false, nil)
+			},
+			wantErr: ErrPVZForbidden,
+		},
+		{
+			name: "ReceptionClosed",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+				repoMock.EXPECT().IsAssigned(ctx, uid, pvzID).Return(true, nil)
+				repoMock.EXPECT().DeleteProduct(ctx, receptionID, productID, uid).Return(repo.ErrNoActiveReception)
+			},
+			wantErr: ErrNoActiveReception,
+		},
+		{
+			name: "ProductNotFound",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+				repoMock.EXPECT().IsAssigned(ctx, uid, pvzID).Return(true, nil)
+				repoMock.EXPECT().DeleteProduct(ctx, receptionID, productID, uid).Return(repo.ErrNotFound)
+			},
+			wantErr: ErrProductNotFound,
+		},
+		{
+			name: "InternalError",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+				repoMock.EXPECT().IsAssigned(ctx, uid, pvzID).Return(true, nil)
+				repoMock.EXPECT().DeleteProduct(ctx, receptionID, productID, uid).Return(testErr)
+			},
+			wantErr: testErr,
+		},
+		{
+			name: "Success",
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+				repoMock.EXPECT().IsAssigned(ctx, uid, pvzID).Return(true, nil)
+				repoMock.EXPECT().DeleteProduct(ctx, receptionID, productID, uid).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				err := ctrl.DeleteProduct(ctx, receptionID, productID)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			},
+		)
+	}
+}
+
+func TestController_UndoLastDelete(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	pvzID := uuid.New()
+	testErr := errors.New("test error")
+
+	repoMock.EXPECT().UndoLastDelete(ctx, pvzID).Return(nil, repo.ErrNoActiveReception)
+	res, err := ctrl.UndoLastDelete(ctx, pvzID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrNoActiveReception)
+
+	repoMock.EXPECT().UndoLastDelete(ctx, pvzID).Return(nil, repo.ErrNoDeletedItems)
+	res, err = ctrl.UndoLastDelete(ctx, pvzID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrNoDeletedItems)
+
+	repoMock.EXPECT().UndoLastDelete(ctx, pvzID).Return(nil, testErr)
+	res, err = ctrl.UndoLastDelete(ctx, pvzID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, testErr)
+
+	product := &dto.Product{ID: dto.NewOptUUID(uuid.New()), Type: "обувь", ReceptionId: uuid.New()}
+	repoMock.EXPECT().UndoLastDelete(ctx, pvzID).Return(product, nil)
+	res, err = ctrl.UndoLastDelete(ctx, pvzID)
+	assert.NoError(t, err)
+	assert.Equal(t, product, res)
+}
+
 func TestController_CreateReception(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -647,7 +1056,7 @@ func TestController_CreateReception(t *testing.T) {
 			},
 			expect: func() {
 				repoMock.EXPECT().
-					CreateReception(ctx, gomock.Any()).
+					CreateReception(ctx, gomock.Any(), uuid.Nil).
 					Return(nil, repo.ErrReceptionStillOpen)
 			},
 			assertions: func(resp *dto.Reception, err error) {
@@ -655,6 +1064,36 @@ func TestController_CreateReception(t *testing.T) {
 				assert.ErrorIs(t, err, ErrReceptionStillOpen)
 			},
 		},
+		{
+			name: "PVZ not found error",
+			req: &dto.ReceptionsPostReq{
+				PvzId: testPVZID,
+			},
+			expect: func() {
+				repoMock.EXPECT().
+					CreateReception(ctx, gomock.Any(), uuid.Nil).
+					Return(nil, repo.ErrNotFound)
+			},
+			assertions: func(resp *dto.Reception, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrPVZNotFound)
+			},
+		},
+		{
+			name: "PVZ closed error",
+			req: &dto.ReceptionsPostReq{
+				PvzId: testPVZID,
+			},
+			expect: func() {
+				repoMock.EXPECT().
+					CreateReception(ctx, gomock.Any(), uuid.Nil).
+					Return(nil, repo.ErrPVZClosed)
+			},
+			assertions: func(resp *dto.Reception, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrPVZClosed)
+			},
+		},
 		{
 			name: "General error",
 			req: &dto.ReceptionsPostReq{
@@ -662,7 +1101,7 @@ func TestController_CreateReception(t *testing.T) {
 			},
 			expect: func() {
 				repoMock.EXPECT().
-					CreateReception(ctx, gomock.Any()).
+					CreateReception(ctx, gomock.Any(), uuid.Nil).
 					Return(nil, generalErr)
 			},
 			assertions: func(resp *dto.Reception, err error) {
@@ -677,7 +1116,7 @@ func TestController_CreateReception(t *testing.T) {
 			},
 			expect: func() {
 				repoMock.EXPECT().
-					CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: testPVZID}).
+					CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: testPVZID}, uuid.Nil).
 					Return(sampleResponse, nil)
 			},
 			assertions: func(resp *dto.Reception, err error) {
@@ -713,7 +1152,7 @@ func TestController_AddItemToReception(t *testing.T) {
 	testType := "validType"
 	baseReq := &dto.ProductsPostReq{
 		PvzId: testPVZID,
-		Type:  dto.ProductsPostReqType(testType),
+		Type:  testType,
 	}
 
 	genericErr := errors.New("generic error")
@@ -735,7 +1174,7 @@ func TestController_AddItemToReception(t *testing.T) {
 			req:  baseReq,
 			expect: func() {
 				repoMock.EXPECT().
-					AddItemToReception(ctx, gomock.Any()).
+					AddItemToReception(ctx, gomock.Any(), uuid.Nil).
 					Return(nil, repo.ErrNoActiveReception)
 			},
 			assertions: func(resp *dto.Product, err error) {
@@ -744,24 +1183,55 @@ func TestController_AddItemToReception(t *testing.T) {
 			},
 		},
 		{
-			name: "Type is not valid error",
-			req:  baseReq,
-			expect: func() {
-				repoMock.EXPECT().
-					AddItemToReception(ctx, gomock.Any()).
-					Return(nil, repo.ErrTypeIsNotValid)
-			},
+			name:   "Barcode checksum error",
+			req:    &dto.ProductsPostReq{PvzId: testPVZID, Type: testType, Barcode: dto.NewOptString("4006381333932")},
+			expect: func() {},
 			assertions: func(resp *dto.Product, err error) {
 				assert.Nil(t, resp)
-				assert.ErrorIs(t, err, ErrTypeIsNotValid)
+				assert.ErrorIs(t, err, ErrBarcodeIsNotValid)
 			},
 		},
 		{
-			name: "General error",
+			name:   "Quantity is not valid error",
+			req:    &dto.ProductsPostReq{PvzId: testPVZID, Type: testType, Quantity: dto.NewOptInt(0)},
+			expect: func() {},
+			assertions: func(resp *dto.Product, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrQuantityIsNotValid)
+			},
+		},
+		{
+			name: "Barcode already exists error",
+			req:  &dto.ProductsPostReq{PvzId: testPVZID, Type: testType, Barcode: dto.NewOptString("4006381333931")},
+			expect: func() {
+				repoMock.EXPECT().
+					AddItemToReception(ctx, gomock.Any(), uuid.Nil).
+					Return(nil, repo.ErrAlreadyExists)
+			},
+			assertions: func(resp *dto.Product, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrBarcodeAlreadyExists)
+			},
+		},
+		{
+			name: "Type is not valid error",
+			req:  baseReq,
+			expect: func() {
+				repoMock.EXPECT().
+					AddItemToReception(ctx, gomock.Any(), uuid.Nil).
+					Return(nil, repo.ErrTypeIsNotValid)
+			},
+			assertions: func(resp *dto.Product, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrTypeIsNotValid)
+			},
+		},
+		{
+			name: "General error",
 			req:  baseReq,
 			expect: func() {
 				repoMock.EXPECT().
-					AddItemToReception(ctx, gomock.Any()).
+					AddItemToReception(ctx, gomock.Any(), uuid.Nil).
 					Return(nil, genericErr)
 			},
 			assertions: func(resp *dto.Product, err error) {
@@ -776,7 +1246,8 @@ func TestController_AddItemToReception(t *testing.T) {
 				repoMock.EXPECT().
 					AddItemToReception(
 						ctx,
-						&dto.ProductsPostReq{PvzId: testPVZID, Type: dto.ProductsPostReqType(testType)},
+						&dto.ProductsPostReq{PvzId: testPVZID, Type: testType},
+						uuid.Nil,
 					).
 					Return(sampleResponse, nil)
 			},
@@ -798,6 +1269,95 @@ func TestController_AddItemToReception(t *testing.T) {
 	}
 }
 
+func TestController_AddItemsToReception(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	testErr := errors.New("test error")
+	ctx := context.WithValue(context.Background(), "uid", uid)
+	scannedAt := time.Now().Add(-time.Minute)
+	req := &dto.ProductsBatchPostReq{
+		PvzId: pvzID,
+		Items: []dto.ProductBatchItem{
+			{Type: "обувь", ScannedAt: dto.NewOptDateTime(scannedAt)},
+			{Type: ""},
+			{Type: "одежда", ScannedAt: dto.NewOptDateTime(time.Now().Add(time.Hour))},
+			{Type: "unknown"},
+			{Type: "обувь", Barcode: dto.NewOptString("4006381333932")},
+			{Type: "обувь", Barcode: dto.NewOptString("4006381333931")},
+		},
+	}
+	valid := []dto.ProductBatchItem{req.Items[0], req.Items[3], req.Items[5]}
+
+	t.Run(
+		"InvalidBatchSize", func(t *testing.T) {
+			res, err := ctrl.AddItemsToReception(ctx, &dto.ProductsBatchPostReq{PvzId: pvzID})
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrInvalidBatchSize)
+
+			res, err = ctrl.AddItemsToReception(
+				ctx, &dto.ProductsBatchPostReq{PvzId: pvzID, Items: make([]dto.ProductBatchItem, MaxBatchSize+1)},
+			)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrInvalidBatchSize)
+		},
+	)
+
+	t.Run(
+		"NoActiveReception", func(t *testing.T) {
+			repoMock.EXPECT().AddItemsToReception(ctx, pvzID, valid, uid).Return(nil, nil, repo.ErrNoActiveReception)
+			res, err := ctrl.AddItemsToReception(ctx, req)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrNoActiveReception)
+		},
+	)
+
+	t.Run(
+		"InternalError", func(t *testing.T) {
+			repoMock.EXPECT().AddItemsToReception(ctx, pvzID, valid, uid).Return(nil, nil, testErr)
+			res, err := ctrl.AddItemsToReception(ctx, req)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, testErr)
+		},
+	)
+
+	t.Run(
+		"PerItemResults", func(t *testing.T) {
+			product := &dto.Product{
+				ID:          dto.NewOptUUID(uuid.New()),
+				DateTime:    dto.NewOptDateTime(scannedAt),
+				Type:        "обувь",
+				ReceptionId: receptionID,
+			}
+			repoMock.EXPECT().AddItemsToReception(ctx, pvzID, valid, uid).Return(
+				[]*dto.Product{product, nil, nil},
+				[]error{nil, repo.ErrTypeIsNotValid, repo.ErrAlreadyExists},
+				nil,
+			)
+
+			res, err := ctrl.AddItemsToReception(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), res.Added)
+			assert.Equal(t, int64(5), res.Failed)
+			assert.Len(t, res.Results, 6)
+			assert.Equal(t, dto.NewOptProduct(*product), res.Results[0].Product)
+			assert.Equal(t, dto.NewOptString(ErrTypeIsNotValid.Error()), res.Results[1].Error)
+			assert.Equal(t, dto.NewOptString(ErrScanTimeInFuture.Error()), res.Results[2].Error)
+			assert.Equal(t, 3, res.Results[3].Index)
+			assert.Equal(t, dto.NewOptString(ErrTypeIsNotValid.Error()), res.Results[3].Error)
+			assert.Equal(t, dto.NewOptString(ErrBarcodeIsNotValid.Error()), res.Results[4].Error)
+			assert.Equal(t, dto.NewOptString(ErrBarcodeAlreadyExists.Error()), res.Results[5].Error)
+		},
+	)
+}
+
 func TestController_GetPVZList(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -810,22 +1370,34 @@ func TestController_GetPVZList(t *testing.T) {
 	testErr := errors.New("test error")
 	samplePVZList := []*md.PVZ{
 		{ID: uuid.New()},
-		{ID: uuid.New()},
+		{ID: uuid.New(), RegistrationDate: time.Now().UTC()},
 	}
+	after := &md.PVZCursor{CreatedAt: time.Now().UTC(), ID: uuid.New()}
 
 	tests := []struct {
 		name       string
+		cursor     string
+		limit      int64
 		expect     func()
-		assertions func(resp []*md.PVZ, err error)
+		assertions func(resp []*md.PVZ, next string, err error)
 	}{
+		{
+			name:   "Invalid cursor",
+			cursor: "%%%",
+			expect: func() {},
+			assertions: func(resp []*md.PVZ, next string, err error) {
+				assert.Nil(t, resp)
+				assert.ErrorIs(t, err, ErrInvalidCursor)
+			},
+		},
 		{
 			name: "Repository error",
 			expect: func() {
 				repoMock.EXPECT().
-					GetPVZList(ctx).
+					GetPVZList(ctx, nil, int64(0)).
 					Return(nil, testErr)
 			},
-			assertions: func(resp []*md.PVZ, err error) {
+			assertions: func(resp []*md.PVZ, next string, err error) {
 				assert.Nil(t, resp)
 				assert.Equal(t, testErr, err)
 			},
@@ -834,13 +1406,29 @@ func TestController_GetPVZList(t *testing.T) {
 			name: "Successful get PVZ list",
 			expect: func() {
 				repoMock.EXPECT().
-					GetPVZList(ctx).
+					GetPVZList(ctx, nil, int64(0)).
 					Return(samplePVZList, nil)
 			},
-			assertions: func(resp []*md.PVZ, err error) {
+			assertions: func(resp []*md.PVZ, next string, err error) {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
 				assert.Len(t, resp, len(samplePVZList))
+				assert.Empty(t, next)
+			},
+		},
+		{
+			name:   "Keyset page",
+			cursor: encodeCursor(after.CreatedAt, after.ID),
+			limit:  2,
+			expect: func() {
+				repoMock.EXPECT().
+					GetPVZList(ctx, after, int64(2)).
+					Return(samplePVZList, nil)
+			},
+			assertions: func(resp []*md.PVZ, next string, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, resp, 2)
+				assert.Equal(t, encodeCursor(samplePVZList[1].RegistrationDate, samplePVZList[1].ID), next)
 			},
 		},
 	}
@@ -849,8 +1437,1332 @@ func TestController_GetPVZList(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.expect()
-				resp, err := ctrl.GetPVZList(ctx)
-				tt.assertions(resp, err)
+				resp, next, err := ctrl.GetPVZList(ctx, tt.cursor, tt.limit)
+				tt.assertions(resp, next, err)
+			},
+		)
+	}
+}
+
+func TestController_WatchPVZ(t *testing.T) {
+	uid := uuid.New()
+	ctx := context.WithValue(context.Background(), "uid", uid)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+
+	events, unsubscribe := ctrl.WatchPVZ(ctx, pvzID, "")
+	defer unsubscribe()
+
+	repoMock.EXPECT().
+		CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: pvzID}, uid).
+		Return(&dto.Reception{ID: dto.OptUUID{Set: true, Value: receptionID}, PvzId: pvzID}, nil)
+	repoMock.EXPECT().
+		AddItemToReception(ctx, &dto.ProductsPostReq{PvzId: pvzID, Type: "обувь"}, uid).
+		Return(
+			&dto.Product{
+				ID:          dto.OptUUID{Set: true, Value: productID},
+				Type:        "обувь",
+				ReceptionId: receptionID,
+			}, nil,
+		)
+	repoMock.EXPECT().
+		DeleteLastProduct(ctx, pvzID, uid).
+		Return(&dto.Product{ID: dto.OptUUID{Set: true, Value: productID}, ReceptionId: receptionID}, nil)
+	repoMock.EXPECT().
+		CloseLastReception(ctx, pvzID, uid, gomock.Any()).
+		Return(&dto.Reception{ID: dto.OptUUID{Set: true, Value: receptionID}, PvzId: pvzID}, nil)
+	repoMock.EXPECT().GetReceptionSummary(ctx, receptionID).Return(nil, repo.ErrNotFound)
+	repoMock.EXPECT().GetPVZCity(ctx, pvzID).Return("Москва", nil).Times(3)
+	repoMock.EXPECT().GetPVZCity(ctx, pvzID).Return("", repo.ErrNotFound)
+
+	_, err := ctrl.CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: pvzID})
+	assert.NoError(t, err)
+	_, err = ctrl.AddItemToReception(ctx, &dto.ProductsPostReq{PvzId: pvzID, Type: "обувь"})
+	assert.NoError(t, err)
+	assert.NoError(t, ctrl.DeleteLastProduct(ctx, pvzID))
+	_, err = ctrl.CloseLastReception(ctx, pvzID)
+	assert.NoError(t, err)
+
+	e := <-events
+	assert.Equal(t, md.EventReceptionOpened, e.Type)
+	assert.Equal(t, receptionID, e.ReceptionID)
+	assert.Equal(t, "Москва", e.City)
+
+	e = <-events
+	assert.Equal(t, md.EventProductAdded, e.Type)
+	assert.Equal(t, productID, e.ProductID)
+	assert.Equal(t, "обувь", e.ProductType)
+
+	e = <-events
+	assert.Equal(t, md.EventProductDeleted, e.Type)
+	assert.Equal(t, pvzID, e.PVZID)
+	assert.Equal(t, receptionID, e.ReceptionID)
+	assert.Equal(t, productID, e.ProductID)
+	assert.Equal(t, uid, e.UserID)
+
+	e = <-events
+	assert.Equal(t, md.EventReceptionClosed, e.Type)
+	assert.Empty(t, e.City)
+}
+
+func TestController_CreateCity(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	testErr := errors.New("test error")
+	req := &dto.DictionaryEntry{Name: "Новосибирск"}
+
+	tests := []struct {
+		name       string
+		expect     func()
+		assertions func(res *dto.DictionaryEntry, err error)
+	}{
+		{
+			name: "Already exists",
+			expect: func() {
+				repoMock.EXPECT().CreateCity(ctx, req).Return(nil, repo.ErrAlreadyExists)
+			},
+			assertions: func(res *dto.DictionaryEntry, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, ErrCityAlreadyExists)
+			},
+		},
+		{
+			name: "Repository error",
+			expect: func() {
+				repoMock.EXPECT().CreateCity(ctx, req).Return(nil, testErr)
+			},
+			assertions: func(res *dto.DictionaryEntry, err error) {
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, testErr)
+			},
+		},
+		{
+			name: "Success",
+			expect: func() {
+				repoMock.EXPECT().CreateCity(ctx, req).Return(
+					&dto.DictionaryEntry{Name: req.Name, IsActive: dto.NewOptBool(true)}, nil,
+				)
+			},
+			assertions: func(res *dto.DictionaryEntry, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, req.Name, res.Name)
+				assert.True(t, res.IsActive.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := ctrl.CreateCity(ctx, req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestController_SetProductTypeActive(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	repoMock.EXPECT().SetProductTypeActive(ctx, "обувь", false).Return(nil, repo.ErrNotFound)
+	res, err := ctrl.SetProductTypeActive(ctx, "обувь", false)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrTypeNotFound)
+
+	repoMock.EXPECT().SetProductTypeActive(ctx, "обувь", false).Return(
+		&dto.DictionaryEntry{Name: "обувь", IsActive: dto.NewOptBool(false)}, nil,
+	)
+	res, err = ctrl.SetProductTypeActive(ctx, "обувь", false)
+	assert.NoError(t, err)
+	assert.False(t, res.IsActive.Value)
+}
+
+func TestController_DeleteDictionaryEntries(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name     string
+		repoErr  error
+		cityErr  error
+		typesErr error
+	}{
+		{name: "Not found", repoErr: repo.ErrNotFound, cityErr: ErrCityNotFound, typesErr: ErrTypeNotFound},
+		{name: "In use", repoErr: repo.ErrInUse, cityErr: ErrCityInUse, typesErr: ErrTypeInUse},
+		{name: "Repository error", repoErr: testErr, cityErr: testErr, typesErr: testErr},
+		{name: "Success"},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				repoMock.EXPECT().DeleteCity(ctx, "Казань").Return(tt.repoErr)
+				assert.ErrorIs(t, ctrl.DeleteCity(ctx, "Казань"), tt.cityErr)
+
+				repoMock.EXPECT().DeleteProductType(ctx, "одежда").Return(tt.repoErr)
+				assert.ErrorIs(t, ctrl.DeleteProductType(ctx, "одежда"), tt.typesErr)
+			},
+		)
+	}
+}
+
+func TestController_Refresh(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	testErr := errors.New("test error")
+	uid := uuid.New()
+
+	tests := []struct {
+		name       string
+		expect     func()
+		assertions func(res dto.Token, refresh string, err error)
+	}{
+		{
+			name: "Unknown refresh token",
+			expect: func() {
+				authMock.EXPECT().NewRefreshToken().Return("new", nil)
+				authMock.EXPECT().HashToken("old").Return("old-hash")
+				authMock.EXPECT().HashToken("new").Return("new-hash")
+				repoMock.EXPECT().RotateRefreshToken(ctx, "old-hash", "new-hash", gomock.Any()).Return(nil, repo.ErrNotFound)
+			},
+			assertions: func(res dto.Token, refresh string, err error) {
+				assert.Empty(t, res)
+				assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken)
+			},
+		},
+		{
+			name: "Repository error",
+			expect: func() {
+				authMock.EXPECT().NewRefreshToken().Return("new", nil)
+				authMock.EXPECT().HashToken("old").Return("old-hash")
+				authMock.EXPECT().HashToken("new").Return("new-hash")
+				repoMock.EXPECT().RotateRefreshToken(ctx, "old-hash", "new-hash", gomock.Any()).Return(nil, testErr)
+			},
+			assertions: func(res dto.Token, refresh string, err error) {
+				assert.Empty(t, res)
+				assert.ErrorIs(t, err, testErr)
+			},
+		},
+		{
+			name: "Success",
+			expect: func() {
+				authMock.EXPECT().NewRefreshToken().Return("new", nil)
+				authMock.EXPECT().HashToken("old").Return("old-hash")
+				authMock.EXPECT().HashToken("new").Return("new-hash")
+				repoMock.EXPECT().RotateRefreshToken(ctx, "old-hash", "new-hash", gomock.Any()).Return(
+					&md.RefreshToken{UserID: uid, Role: md.EmployeeRole}, nil,
+				)
+				authMock.EXPECT().NewToken(uid, md.EmployeeRole).Return("access", nil)
+			},
+			assertions: func(res dto.Token, refresh string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, dto.Token("access"), res)
+				assert.Equal(t, "new", refresh)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, refresh, err := ctrl.Refresh(ctx, "old")
+				tt.assertions(res, refresh, err)
+			},
+		)
+	}
+}
+
+func TestController_Logout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	testErr := errors.New("test error")
+	uid := uuid.New()
+	ctx := context.WithValue(context.WithValue(context.Background(), "uid", uid), "jti", "jti-1")
+
+	tests := []struct {
+		name    string
+		refresh string
+		expect  func()
+		wantErr error
+	}{
+		{
+			name: "Access token only",
+			expect: func() {
+				repoMock.EXPECT().RevokeToken(ctx, "jti-1", gomock.Any()).Return(nil)
+				authMock.EXPECT().MarkRevoked("jti-1", gomock.Any())
+			},
+		},
+		{
+			name:    "With refresh token",
+			refresh: "refresh",
+			expect: func() {
+				authMock.EXPECT().HashToken("refresh").Return("refresh-hash")
+				repoMock.EXPECT().RevokeRefreshToken(ctx, "refresh-hash", uid).Return(nil)
+				repoMock.EXPECT().RevokeToken(ctx, "jti-1", gomock.Any()).Return(nil)
+				authMock.EXPECT().MarkRevoked("jti-1", gomock.Any())
+			},
+		},
+		{
+			name: "RevokeToken error",
+			expect: func() {
+				repoMock.EXPECT().RevokeToken(ctx, "jti-1", gomock.Any()).Return(testErr)
+			},
+			wantErr: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				err := ctrl.Logout(ctx, tt.refresh)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+}
+
+func TestController_AssignPVZ(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	testErr := errors.New("test error")
+
+	repoMock.EXPECT().AssignPVZ(ctx, uid, pvzID).Return(nil, repo.ErrNotFound)
+	res, err := ctrl.AssignPVZ(ctx, uid, pvzID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrEmployeeOrPVZNotFound)
+
+	repoMock.EXPECT().AssignPVZ(ctx, uid, pvzID).Return(nil, testErr)
+	res, err = ctrl.AssignPVZ(ctx, uid, pvzID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, testErr)
+
+	repoMock.EXPECT().AssignPVZ(ctx, uid, pvzID).Return(&dto.PVZAssignment{UserId: uid, PvzId: pvzID}, nil)
+	res, err = ctrl.AssignPVZ(ctx, uid, pvzID)
+	assert.NoError(t, err)
+	assert.Equal(t, pvzID, res.PvzId)
+
+	repoMock.EXPECT().UnassignPVZ(ctx, uid, pvzID).Return(repo.ErrNotFound)
+	assert.ErrorIs(t, ctrl.UnassignPVZ(ctx, uid, pvzID), ErrAssignmentNotFound)
+
+	repoMock.EXPECT().UnassignPVZ(ctx, uid, pvzID).Return(nil)
+	assert.NoError(t, ctrl.UnassignPVZ(ctx, uid, pvzID))
+}
+
+func TestController_PVZScope(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	testErr := errors.New("test error")
+	employee := context.WithValue(context.WithValue(context.Background(), "uid", uid), "role", md.EmployeeRole)
+	moderator := context.WithValue(context.WithValue(context.Background(), "uid", uid), "role", md.ModeratorRole)
+
+	calls := map[string]func(ctx context.Context) error{
+		"CreateReception": func(ctx context.Context) error {
+			_, err := ctrl.CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: pvzID})
+			return err
+		},
+		"AddItemToReception": func(ctx context.Context) error {
+			_, err := ctrl.AddItemToReception(ctx, &dto.ProductsPostReq{PvzId: pvzID, Type: "обувь"})
+			return err
+		},
+		"DeleteLastProduct": func(ctx context.Context) error {
+			return ctrl.DeleteLastProduct(ctx, pvzID)
+		},
+		"UndoLastDelete": func(ctx context.Context) error {
+			_, err := ctrl.UndoLastDelete(ctx, pvzID)
+			return err
+		},
+		"CloseLastReception": func(ctx context.Context) error {
+			_, err := ctrl.CloseLastReception(ctx, pvzID)
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(
+			name+"/NotAssigned", func(t *testing.T) {
+				repoMock.EXPECT().IsAssigned(employee, uid, pvzID).Return(false, nil)
+				assert.ErrorIs(t, call(employee), ErrPVZForbidden)
+			},
+		)
+		t.Run(
+			name+"/AssignmentCheckFailed", func(t *testing.T) {
+				repoMock.EXPECT().IsAssigned(employee, uid, pvzID).Return(false, testErr)
+				assert.ErrorIs(t, call(employee), testErr)
+			},
+		)
+	}
+
+	t.Run(
+		"AssignedEmployee", func(t *testing.T) {
+			repoMock.EXPECT().IsAssigned(employee, uid, pvzID).Return(true, nil)
+			repoMock.EXPECT().DeleteLastProduct(employee, pvzID, uid).Return(&dto.Product{}, nil)
+			assert.NoError(t, ctrl.DeleteLastProduct(employee, pvzID))
+		},
+	)
+
+	t.Run(
+		"ModeratorIsNotScoped", func(t *testing.T) {
+			repoMock.EXPECT().CloseLastReception(moderator, pvzID, uid, gomock.Any()).Return(nil, repo.ErrReceptionAlreadyClosed)
+			_, err := ctrl.CloseLastReception(moderator, pvzID)
+			assert.ErrorIs(t, err, ErrReceptionAlreadyClosed)
+		},
+	)
+}
+
+func TestController_ListAudit(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	filter := &md.AuditFilter{Action: md.AuditProductDeleted, Page: 1, Limit: 50}
+	testErr := errors.New("test error")
+
+	repoMock.EXPECT().ListAudit(ctx, filter).Return(nil, testErr)
+	res, err := ctrl.ListAudit(ctx, filter)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, testErr)
+
+	repoMock.EXPECT().ListAudit(ctx, filter).Return([]*dto.AuditEntry{{ID: 1, Action: md.AuditProductDeleted}}, nil)
+	res, err = ctrl.ListAudit(ctx, filter)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+}
+
+func TestController_BeginIdempotent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	testErr := errors.New("test error")
+	ctx := context.WithValue(context.Background(), "uid", uid)
+	stored := &md.IdempotencyKey{
+		UserID:      uid,
+		Key:         "key",
+		RequestHash: "hash",
+		StatusCode:  201,
+		Response:    []byte(`{}`),
+		Completed:   true,
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		expect   func()
+		expected *md.IdempotencyKey
+		err      error
+	}{
+		{
+			name: "Claimed",
+			hash: "hash",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name: "ClaimError",
+			hash: "hash",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(false, testErr)
+			},
+			err: testErr,
+		},
+		{
+			name: "Replay",
+			hash: "hash",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repoMock.EXPECT().GetIdempotencyKey(ctx, uid, "key").Return(stored, nil)
+			},
+			expected: stored,
+		},
+		{
+			name: "Reused",
+			hash: "other",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repoMock.EXPECT().GetIdempotencyKey(ctx, uid, "key").Return(stored, nil)
+			},
+			err: ErrIdempotencyKeyReused,
+		},
+		{
+			name: "InProgress",
+			hash: "hash",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repoMock.EXPECT().GetIdempotencyKey(ctx, uid, "key").Return(
+					&md.IdempotencyKey{UserID: uid, Key: "key", RequestHash: "hash"}, nil,
+				)
+			},
+			err: ErrIdempotencyKeyInProgress,
+		},
+		{
+			name: "Released",
+			hash: "hash",
+			expect: func() {
+				repoMock.EXPECT().ClaimIdempotencyKey(ctx, gomock.Any()).Return(false, nil)
+				repoMock.EXPECT().GetIdempotencyKey(ctx, uid, "key").Return(nil, repo.ErrNotFound)
+			},
+			err: ErrIdempotencyKeyInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				k := &md.IdempotencyKey{Key: "key", RequestHash: tt.hash}
+				res, err := ctrl.BeginIdempotent(ctx, k)
+				assert.Equal(t, tt.expected, res)
+				assert.ErrorIs(t, err, tt.err)
+				assert.Equal(t, uid, k.UserID)
+				assert.True(t, k.ExpiresAt.After(time.Now()))
+			},
+		)
+	}
+}
+
+func TestValidateBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		err     error
+	}{
+		{name: "EAN13", barcode: "4006381333931"},
+		{name: "EAN13Sample", barcode: "5901234123457"},
+		{name: "EAN13WrongCheckDigit", barcode: "4006381333932", err: ErrBarcodeIsNotValid},
+		{name: "Code128", barcode: "ABC-123/x"},
+		{name: "Code128Digits", barcode: "123456"},
+		{name: "Empty", barcode: "", err: ErrBarcodeIsNotValid},
+		{name: "TooLong", barcode: strings.Repeat("A", maxCode128Len+1), err: ErrBarcodeIsNotValid},
+		{name: "NotASCII", barcode: "штрихкод", err: ErrBarcodeIsNotValid},
+		{name: "ControlCharacter", barcode: "AB\tC", err: ErrBarcodeIsNotValid},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.ErrorIs(t, validateBarcode(tt.barcode), tt.err)
+			},
+		)
+	}
+}
+
+func TestController_FindProductsByBarcode(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	const barcode = "4006381333931"
+	testErr := errors.New("test error")
+
+	res, err := ctrl.FindProductsByBarcode(ctx, "4006381333932")
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrBarcodeIsNotValid)
+
+	repoMock.EXPECT().FindProductsByBarcode(ctx, barcode).Return(nil, testErr)
+	res, err = ctrl.FindProductsByBarcode(ctx, barcode)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, testErr)
+
+	repoMock.EXPECT().FindProductsByBarcode(ctx, barcode).Return([]*dto.Product{{Barcode: dto.NewOptString(barcode)}}, nil)
+	res, err = ctrl.FindProductsByBarcode(ctx, barcode)
+	assert.NoError(t, err)
+	assert.Len(t, res, 1)
+}
+
+func TestReconcile(t *testing.T) {
+	receptionID := uuid.New()
+	manifest := &dto.Manifest{
+		ReceptionId: receptionID,
+		Items: []dto.ManifestItem{
+			{Type: dto.NewOptString("обувь"), Quantity: 3},
+			{Type: dto.NewOptString("одежда"), Barcode: dto.NewOptString("4006381333931"), Quantity: 2},
+			{Barcode: dto.NewOptString("5901234123457"), Quantity: 1},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		counts     []*md.ProductCount
+		reconciled bool
+		missing    []dto.DiscrepancyLine
+		surplus    []dto.DiscrepancyLine
+		unexpected []dto.DiscrepancyLine
+	}{
+		{
+			name: "Reconciled",
+			counts: []*md.ProductCount{
+				{Type: "обувь", Quantity: 2},
+				{Type: "обувь", Barcode: sql.NullString{String: "ABC-1", Valid: true}, Quantity: 1},
+				{Type: "одежда", Barcode: sql.NullString{String: "4006381333931", Valid: true}, Quantity: 2},
+				{Type: "электроника", Barcode: sql.NullString{String: "5901234123457", Valid: true}, Quantity: 1},
+			},
+			reconciled: true,
+		},
+		{
+			name:       "NothingAccepted",
+			counts:     []*md.ProductCount{},
+			reconciled: false,
+			missing: []dto.DiscrepancyLine{
+				{Barcode: dto.NewOptString("5901234123457"), Expected: 1},
+				{Type: dto.NewOptString("обувь"), Expected: 3},
+				{Type: dto.NewOptString("одежда"), Barcode: dto.NewOptString("4006381333931"), Expected: 2},
+			},
+		},
+		{
+			name: "SurplusAndUnexpected",
+			counts: []*md.ProductCount{
+				{Type: "обувь", Quantity: 4},
+				{Type: "одежда", Quantity: 1},
+				{Type: "одежда", Barcode: sql.NullString{String: "4006381333931", Valid: true}, Quantity: 2},
+				{Type: "электроника", Barcode: sql.NullString{String: "5901234123457", Valid: true}, Quantity: 1},
+			},
+			reconciled: false,
+			surplus: []dto.DiscrepancyLine{
+				{Type: dto.NewOptString("обувь"), Expected: 3, Actual: 4},
+			},
+			unexpected: []dto.DiscrepancyLine{
+				{Type: dto.NewOptString("одежда"), Actual: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				res := reconcile(manifest, tt.counts)
+				assert.Equal(t, receptionID, res.ReceptionId)
+				assert.Equal(t, tt.reconciled, res.Reconciled)
+				assert.ElementsMatch(t, tt.missing, res.Missing)
+				assert.ElementsMatch(t, tt.surplus, res.Surplus)
+				assert.ElementsMatch(t, tt.unexpected, res.Unexpected)
+			},
+		)
+	}
+}
+
+func TestController_UploadManifest(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	receptionID := uuid.New()
+	pvzID := uuid.New()
+	testErr := errors.New("test error")
+	items := []dto.ManifestItem{
+		{Type: dto.NewOptString("обувь"), Quantity: 1},
+		{Type: dto.NewOptString("обувь"), Quantity: 2},
+		{Type: dto.NewOptString("одежда"), Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+	}
+	merged := []dto.ManifestItem{
+		{Type: dto.NewOptString("обувь"), Quantity: 3},
+		{Type: dto.NewOptString("одежда"), Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+	}
+
+	tests := []struct {
+		name   string
+		items  []dto.ManifestItem
+		expect func()
+		err    error
+	}{
+		{
+			name:   "Empty",
+			items:  []dto.ManifestItem{},
+			expect: func() {},
+			err:    ErrInvalidManifestSize,
+		},
+		{
+			name:   "NoTypeOrBarcode",
+			items:  []dto.ManifestItem{{Type: dto.NewOptString(""), Quantity: 1}},
+			expect: func() {},
+			err:    ErrManifestItemIsNotValid,
+		},
+		{
+			name:   "InvalidQuantity",
+			items:  []dto.ManifestItem{{Type: dto.NewOptString("обувь")}},
+			expect: func() {},
+			err:    ErrQuantityIsNotValid,
+		},
+		{
+			name:   "InvalidBarcode",
+			items:  []dto.ManifestItem{{Barcode: dto.NewOptString("4006381333932"), Quantity: 1}},
+			expect: func() {},
+			err:    ErrBarcodeIsNotValid,
+		},
+		{
+			name: "BarcodeWithDifferentTypes",
+			items: []dto.ManifestItem{
+				{Type: dto.NewOptString("обувь"), Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+				{Type: dto.NewOptString("одежда"), Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+			},
+			expect: func() {},
+			err:    ErrManifestBarcodeConflict,
+		},
+		{
+			name:  "ReceptionNotFound",
+			items: items,
+			expect: func() {
+				repoMock.EXPECT().GetReception(ctx, receptionID).Return(nil, repo.ErrNotFound)
+			},
+			err: ErrReceptionNotFound,
+		},
+		{
+			name:  "ReceptionClosed",
+			items: items,
+			expect: func() {
+				repoMock.EXPECT().
+					GetReception(ctx, receptionID).
+					Return(&md.Reception{ID: receptionID, PVZID: pvzID, Status: "close"}, nil)
+			},
+			err: ErrReceptionAlreadyClosed,
+		},
+		{
+			name:  "UnknownType",
+			items: items,
+			expect: func() {
+				repoMock.EXPECT().
+					GetReception(ctx, receptionID).
+					Return(&md.Reception{ID: receptionID, PVZID: pvzID, Status: "in_progress"}, nil)
+				repoMock.EXPECT().
+					ReplaceManifest(ctx, receptionID, true, merged, uuid.Nil).
+					Return(nil, repo.ErrTypeIsNotValid)
+			},
+			err: ErrTypeIsNotValid,
+		},
+		{
+			name:  "RepoError",
+			items: items,
+			expect: func() {
+				repoMock.EXPECT().
+					GetReception(ctx, receptionID).
+					Return(&md.Reception{ID: receptionID, PVZID: pvzID, Status: "in_progress"}, nil)
+				repoMock.EXPECT().
+					ReplaceManifest(ctx, receptionID, true, merged, uuid.Nil).
+					Return(nil, testErr)
+			},
+			err: testErr,
+		},
+		{
+			name:  "Success",
+			items: items,
+			expect: func() {
+				repoMock.EXPECT().
+					GetReception(ctx, receptionID).
+					Return(&md.Reception{ID: receptionID, PVZID: pvzID, Status: "in_progress"}, nil)
+				repoMock.EXPECT().
+					ReplaceManifest(ctx, receptionID, true, merged, uuid.Nil).
+					Return(&dto.Manifest{ReceptionId: receptionID, Strict: true, Items: merged}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := ctrl.UploadManifest(ctx, receptionID, true, tt.items)
+				if tt.err != nil {
+					assert.Nil(t, res)
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, merged, res.Items)
+			},
+		)
+	}
+}
+
+func TestController_GetDiscrepancies(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	receptionID := uuid.New()
+	reception := &md.Reception{ID: receptionID, PVZID: uuid.New(), Status: "in_progress"}
+
+	repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+	repoMock.EXPECT().GetManifest(ctx, receptionID).Return(nil, repo.ErrNotFound)
+	res, err := ctrl.GetDiscrepancies(ctx, receptionID)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrManifestNotFound)
+
+	repoMock.EXPECT().GetReception(ctx, receptionID).Return(reception, nil)
+	repoMock.EXPECT().
+		GetManifest(ctx, receptionID).
+		Return(&dto.Manifest{ReceptionId: receptionID, Items: []dto.ManifestItem{{Type: dto.NewOptString("обувь"), Quantity: 1}}}, nil)
+	repoMock.EXPECT().
+		GetReceptionProductCounts(ctx, receptionID).
+		Return([]*md.ProductCount{{Type: "обувь", Quantity: 1}}, nil)
+	res, err = ctrl.GetDiscrepancies(ctx, receptionID)
+	assert.NoError(t, err)
+	assert.True(t, res.Reconciled)
+}
+
+func TestController_CloseIdleReceptions(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	const reason = "no products added for 12h0m0s"
+	idleFor := 12 * time.Hour
+	testErr := errors.New("test error")
+
+	full := make([]*md.Reception, autoCloseBatchSize)
+	for i := range full {
+		full[i] = &md.Reception{ID: uuid.New(), PVZID: uuid.New(), Status: "closed"}
+	}
+	repoMock.EXPECT().GetPVZCity(ctx, gomock.Any()).Return("Москва", nil).AnyTimes()
+
+	t.Run(
+		"ClosesInBatches", func(t *testing.T) {
+			gomock.InOrder(
+				repoMock.EXPECT().CloseIdleReceptions(ctx, idleFor, reason, autoCloseBatchSize).Return(full, nil),
+				repoMock.EXPECT().CloseIdleReceptions(ctx, idleFor, reason, autoCloseBatchSize).Return(full[:1], nil),
+			)
+
+			closed, err := ctrl.CloseIdleReceptions(ctx, idleFor)
+			assert.NoError(t, err)
+			assert.Equal(t, autoCloseBatchSize+1, closed)
+		},
+	)
+
+	t.Run(
+		"NothingToClose", func(t *testing.T) {
+			repoMock.EXPECT().CloseIdleReceptions(ctx, idleFor, reason, autoCloseBatchSize).Return([]*md.Reception{}, nil)
+
+			closed, err := ctrl.CloseIdleReceptions(ctx, idleFor)
+			assert.NoError(t, err)
+			assert.Zero(t, closed)
+		},
+	)
+
+	t.Run(
+		"RepoError", func(t *testing.T) {
+			repoMock.EXPECT().CloseIdleReceptions(ctx, idleFor, reason, autoCloseBatchSize).Return(nil, testErr)
+
+			closed, err := ctrl.CloseIdleReceptions(ctx, idleFor)
+			assert.ErrorIs(t, err, testErr)
+			assert.Zero(t, closed)
+		},
+	)
+}
+
+func TestController_ReopenReception(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+	ctrl.SetReopenWindow(time.Hour)
+
+	const reason = "products were scanned into the wrong reception"
+	uid := uuid.New()
+	ctx := context.WithValue(context.Background(), "uid", uid)
+	testErr := errors.New("test error")
+	testID := uuid.New()
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "Not found", repoErr: repo.ErrNotFound, wantErr: ErrReceptionNotFound},
+		{name: "Still open", repoErr: repo.ErrReceptionStillOpen, wantErr: ErrReceptionStillOpen},
+		{name: "Newer reception exists", repoErr: repo.ErrNewerReceptionExists, wantErr: ErrNewerReceptionExists},
+		{name: "Window expired", repoErr: repo.ErrReopenWindowExpired, wantErr: ErrReopenWindowExpired},
+		{name: "General error", repoErr: testErr, wantErr: testErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				repoMock.EXPECT().ReopenReception(ctx, testID, uid, reason, time.Hour).Return(nil, tt.repoErr)
+				res, err := ctrl.ReopenReception(ctx, testID, reason)
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			pvzID := uuid.New()
+			events, cancel := ctrl.WatchPVZ(ctx, pvzID, "")
+			defer cancel()
+
+			repoMock.EXPECT().GetPVZCity(ctx, pvzID).Return("Москва", nil).AnyTimes()
+			repoMock.EXPECT().ReopenReception(ctx, testID, uid, reason, time.Hour).Return(
+				&dto.Reception{
+					ID:           dto.NewOptUUID(testID),
+					PvzId:        pvzID,
+					Status:       dto.ReceptionStatusInProgress,
+					ReopenReason: dto.NewOptString(reason),
+				}, nil,
+			)
+
+			res, err := ctrl.ReopenReception(ctx, testID, reason)
+			assert.NoError(t, err)
+			assert.Equal(t, dto.ReceptionStatusInProgress, res.Status)
+
+			ev := <-events
+			assert.Equal(t, md.EventReceptionOpened, ev.Type)
+			assert.Equal(t, testID, ev.ReceptionID)
+		},
+	)
+}
+
+func TestController_UpdatePVZ(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	testID := uuid.New()
+	req := &dto.PVZUpdate{Name: dto.NewOptString("ПВЗ на Баумана")}
+
+	t.Run(
+		"Nothing to update", func(t *testing.T) {
+			res, err := ctrl.UpdatePVZ(ctx, testID, &dto.PVZUpdate{})
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrNothingToUpdate)
+		},
+	)
+
+	t.Run(
+		"Working hours not valid", func(t *testing.T) {
+			res, err := ctrl.UpdatePVZ(
+				ctx, testID, &dto.PVZUpdate{
+					WorkingHours: dto.WorkingHours{
+						{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"},
+						{Day: dto.WorkingHoursItemDayMon, Open: "10:00", Close: "20:00"},
+					},
+				},
+			)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrWorkingHoursIsNotValid)
+		},
+	)
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "Not found", repoErr: repo.ErrNotFound, wantErr: ErrPVZNotFound},
+		{name: "Closed", repoErr: repo.ErrPVZClosed, wantErr: ErrPVZClosed},
+		{name: "City not valid", repoErr: repo.ErrCityIsNotValid, wantErr: ErrCityIsNotValid},
+		{name: "Timezone required", repoErr: repo.ErrTimezoneRequired, wantErr: ErrTimezoneRequired},
+		{name: "General error", repoErr: testErr, wantErr: testErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				repoMock.EXPECT().UpdatePVZ(ctx, testID, req).Return(nil, tt.repoErr)
+				res, err := ctrl.UpdatePVZ(ctx, testID, req)
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			repoMock.EXPECT().UpdatePVZ(ctx, testID, req).Return(
+				&dto.PVZ{
+					ID:     dto.NewOptUUID(testID),
+					City:   "Москва",
+					Name:   req.Name,
+					Status: dto.NewOptPVZStatus(dto.PVZStatusActive),
+				}, nil,
+			)
+
+			res, err := ctrl.UpdatePVZ(ctx, testID, req)
+			assert.NoError(t, err)
+			assert.Equal(t, "ПВЗ на Баумана", res.Name.Value)
+		},
+	)
+}
+
+func TestController_DecommissionPVZ(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	uid := uuid.New()
+	ctx := context.WithValue(context.Background(), "uid", uid)
+	testErr := errors.New("test error")
+	testID := uuid.New()
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "Not found", repoErr: repo.ErrNotFound, wantErr: ErrPVZNotFound},
+		{name: "Already closed", repoErr: repo.ErrPVZClosed, wantErr: ErrPVZClosed},
+		{name: "Reception still open", repoErr: repo.ErrReceptionStillOpen, wantErr: ErrReceptionStillOpen},
+		{name: "General error", repoErr: testErr, wantErr: testErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				repoMock.EXPECT().DecommissionPVZ(ctx, testID, uid).Return(nil, tt.repoErr)
+				res, err := ctrl.DecommissionPVZ(ctx, testID)
+				assert.Nil(t, res)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			repoMock.EXPECT().DecommissionPVZ(ctx, testID, uid).Return(
+				&dto.PVZ{
+					ID:     dto.NewOptUUID(testID),
+					City:   "Москва",
+					Status: dto.NewOptPVZStatus(dto.PVZStatusClosed),
+				}, nil,
+			)
+
+			res, err := ctrl.DecommissionPVZ(ctx, testID)
+			assert.NoError(t, err)
+			assert.Equal(t, dto.PVZStatusClosed, res.Status.Value)
+		},
+	)
+}
+
+func TestValidateWorkingHours(t *testing.T) {
+	tests := []struct {
+		name    string
+		hours   dto.WorkingHours
+		wantErr bool
+	}{
+		{name: "Empty", hours: nil},
+		{
+			name: "Valid",
+			hours: dto.WorkingHours{
+				{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"},
+				{Day: dto.WorkingHoursItemDaySat, Open: "10:00", Close: "18:30"},
+			},
+		},
+		{
+			name:    "Unknown day",
+			hours:   dto.WorkingHours{{Day: "holiday", Open: "09:00", Close: "21:00"}},
+			wantErr: true,
+		},
+		{
+			name: "Duplicate day",
+			hours: dto.WorkingHours{
+				{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "13:00"},
+				{Day: dto.WorkingHoursItemDayMon, Open: "14:00", Close: "21:00"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Bad time",
+			hours:   dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "25:00", Close: "21:00"}},
+			wantErr: true,
+		},
+		{
+			name:    "Closes before opening",
+			hours:   dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "21:00", Close: "21:00"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := validateWorkingHours(tt.hours)
+				if tt.wantErr {
+					assert.ErrorIs(t, err, ErrWorkingHoursIsNotValid)
+				} else {
+					assert.NoError(t, err)
+				}
+			},
+		)
+	}
+}
+
+func TestValidateLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		tz       dto.OptString
+		coords   dto.OptCoordinates
+		capacity dto.OptInt
+		wantErr  error
+	}{
+		{name: "Empty"},
+		{
+			name:     "Valid",
+			tz:       dto.NewOptString("Asia/Yekaterinburg"),
+			coords:   dto.NewOptCoordinates(dto.Coordinates{Latitude: -33.8688, Longitude: 151.2093}),
+			capacity: dto.NewOptInt(1),
+		},
+		{name: "Unknown timezone", tz: dto.NewOptString("Mars/Olympus"), wantErr: ErrTimezoneIsNotValid},
+		{name: "Local timezone", tz: dto.NewOptString("Local"), wantErr: ErrTimezoneIsNotValid},
+		{
+			name:    "Longitude out of range",
+			coords:  dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7, Longitude: 181}),
+			wantErr: ErrCoordinatesIsNotValid,
+		},
+		{
+			name:    "NaN latitude",
+			coords:  dto.NewOptCoordinates(dto.Coordinates{Latitude: math.NaN(), Longitude: 37.6}),
+			wantErr: ErrCoordinatesIsNotValid,
+		},
+		{name: "Zero capacity", capacity: dto.NewOptInt(0), wantErr: ErrCapacityIsNotValid},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := validateLocation(tt.tz, tt.coords, tt.capacity)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			},
+		)
+	}
+}
+
+func TestController_FindNearbyPVZ(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repoMock := mocks.NewMockAppRepo(mockCtrl)
+	authMock := mocks.NewMockCore(mockCtrl)
+	ctrl := New(repoMock, authMock)
+
+	ctx := context.Background()
+	testErr := errors.New("test error")
+	const lat, lon, radius = 55.7558, 37.6173, 3000.0
+
+	// The schedule is built around noon in the chosen timezone, so the PVZ is open whenever the test runs.
+	tz := fmt.Sprintf("Etc/GMT%+d", time.Now().UTC().Hour()-12)
+	if tz == "Etc/GMT+0" {
+		tz = "Etc/GMT"
+	}
+	hours := make(dto.WorkingHours, 0, len(weekdays))
+	for _, day := range weekdays {
+		hours = append(hours, dto.WorkingHoursItem{Day: day, Open: "09:00", Close: "18:00"})
+	}
+	open := func(d float64) *dto.NearbyPVZ {
+		return &dto.NearbyPVZ{
+			Pvz:      dto.PVZ{ID: dto.NewOptUUID(uuid.New()), Timezone: dto.NewOptString(tz), WorkingHours: hours},
+			Distance: d,
+		}
+	}
+	closed := func(d float64) *dto.NearbyPVZ {
+		return &dto.NearbyPVZ{Pvz: dto.PVZ{ID: dto.NewOptUUID(uuid.New())}, Distance: d}
+	}
+
+	t.Run(
+		"Coordinates not valid", func(t *testing.T) {
+			res, err := ctrl.FindNearbyPVZ(ctx, 91, lon, radius, false, 0)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrCoordinatesIsNotValid)
+		},
+	)
+
+	t.Run(
+		"Radius not valid", func(t *testing.T) {
+			res, err := ctrl.FindNearbyPVZ(ctx, lat, lon, MaxNearbyRadius+1, false, 0)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, ErrRadiusIsNotValid)
+		},
+	)
+
+	t.Run(
+		"Default limit", func(t *testing.T) {
+			repoMock.EXPECT().FindNearbyPVZs(ctx, lat, lon, radius, nil, int64(DefaultNearbyLimit)).Return([]*dto.NearbyPVZ{}, nil)
+			res, err := ctrl.FindNearbyPVZ(ctx, lat, lon, radius, false, 0)
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+		},
+	)
+
+	t.Run(
+		"Limit capped", func(t *testing.T) {
+			repoMock.EXPECT().FindNearbyPVZs(ctx, lat, lon, radius, nil, int64(MaxNearbyLimit)).Return([]*dto.NearbyPVZ{}, nil)
+			_, err := ctrl.FindNearbyPVZ(ctx, lat, lon, radius, false, 1000)
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Open now", func(t *testing.T) {
+			items := []*dto.NearbyPVZ{closed(100), open(200), closed(300), open(400), open(500)}
+			repoMock.EXPECT().FindNearbyPVZs(ctx, lat, lon, radius, nil, int64(MaxNearbyLimit)).Return(items, nil)
+
+			res, err := ctrl.FindNearbyPVZ(ctx, lat, lon, radius, true, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, []*dto.NearbyPVZ{items[1], items[3]}, res)
+		},
+	)
+
+	t.Run(
+		"Open now across pages", func(t *testing.T) {
+			first := make([]*dto.NearbyPVZ, 0, MaxNearbyLimit)
+			for i := range MaxNearbyLimit {
+				first = append(first, closed(float64(i)))
+			}
+			last := first[len(first)-1]
+			second := []*dto.NearbyPVZ{open(1000), closed(1100)}
+
+			repoMock.EXPECT().FindNearbyPVZs(ctx, lat, lon, radius, nil, int64(MaxNearbyLimit)).Return(first, nil)
+			repoMock.EXPECT().
+				FindNearbyPVZs(
+					ctx, lat, lon, radius, &md.NearbyCursor{Distance: last.Distance, ID: last.Pvz.ID.Value},
+					int64(MaxNearbyLimit),
+				).
+				Return(second, nil)
+
+			res, err := ctrl.FindNearbyPVZ(ctx, lat, lon, radius, true, 5)
+			assert.NoError(t, err)
+			assert.Equal(t, []*dto.NearbyPVZ{second[0]}, res)
+		},
+	)
+
+	t.Run(
+		"Repo error", func(t *testing.T) {
+			repoMock.EXPECT().FindNearbyPVZs(ctx, lat, lon, radius, nil, int64(DefaultNearbyLimit)).Return(nil, testErr)
+			res, err := ctrl.FindNearbyPVZ(ctx, lat, lon, radius, false, 0)
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, testErr)
+		},
+	)
+}
+
+func TestIsOpenAt(t *testing.T) {
+	pvz := &dto.PVZ{
+		Timezone: dto.NewOptString("Asia/Yekaterinburg"),
+		WorkingHours: dto.WorkingHours{
+			{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"},
+			{Day: dto.WorkingHoursItemDaySat, Open: "10:00", Close: "16:00"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		pvz  *dto.PVZ
+		at   time.Time
+		want bool
+	}{
+		// Asia/Yekaterinburg is UTC+5, 2024-06-03 is a Monday.
+		{name: "Open", pvz: pvz, at: time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC), want: true},
+		{name: "At opening", pvz: pvz, at: time.Date(2024, 6, 3, 4, 0, 0, 0, time.UTC), want: true},
+		{name: "Before opening", pvz: pvz, at: time.Date(2024, 6, 3, 3, 59, 0, 0, time.UTC), want: false},
+		{name: "At closing", pvz: pvz, at: time.Date(2024, 6, 3, 16, 0, 0, 0, time.UTC), want: false},
+		{name: "Already Tuesday locally", pvz: pvz, at: time.Date(2024, 6, 3, 20, 0, 0, 0, time.UTC), want: false},
+		{name: "Saturday", pvz: pvz, at: time.Date(2024, 6, 8, 6, 0, 0, 0, time.UTC), want: true},
+		{name: "Day off", pvz: pvz, at: time.Date(2024, 6, 4, 8, 0, 0, 0, time.UTC), want: false},
+		{
+			name: "No timezone",
+			pvz:  &dto.PVZ{WorkingHours: pvz.WorkingHours},
+			at:   time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC),
+			want: false,
+		},
+		{name: "No schedule", pvz: &dto.PVZ{Timezone: pvz.Timezone}, at: time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, isOpenAt(tt.pvz, tt.at))
 			},
 		)
 	}
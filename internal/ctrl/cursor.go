@@ -0,0 +1,36 @@
+package ctrl
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/google/uuid"
+	"time"
+)
+
+// encodeCursor makes an opaque token out of the last PVZ of a page.
+func encodeCursor(createdAt time.Time, id uuid.UUID) string {
+	b, err := json.Marshal(md.PVZCursor{CreatedAt: createdAt, ID: id})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(cursor string) (*md.PVZCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	res := &md.PVZCursor{}
+	if err = json.Unmarshal(b, res); err != nil || res.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return res, nil
+}
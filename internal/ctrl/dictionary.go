@@ -0,0 +1,123 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"go.uber.org/zap"
+)
+
+func (c *Controller) ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	res, err := c.repo.ListCities(ctx)
+	if err != nil {
+		zap.L().Error("Failed to list cities", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	res, err := c.repo.CreateCity(ctx, req)
+	if err != nil {
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			zap.L().Debug("City already exists", zap.String("name", req.Name))
+			return nil, ErrCityAlreadyExists
+		}
+		zap.L().Error("Failed to create city", zap.String("name", req.Name), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	res, err := c.repo.SetCityActive(ctx, name, active)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("City not found", zap.String("name", name))
+			return nil, ErrCityNotFound
+		}
+		zap.L().Error("Failed to update city", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteCity removes a city that no PVZ refers to. Cities in use can only be deactivated.
+func (c *Controller) DeleteCity(ctx context.Context, name string) error {
+	err := c.repo.DeleteCity(ctx, name)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("City not found", zap.String("name", name))
+			return ErrCityNotFound
+		}
+		if errors.Is(err, repo.ErrInUse) {
+			zap.L().Debug("City is in use", zap.String("name", name))
+			return ErrCityInUse
+		}
+		zap.L().Error("Failed to delete city", zap.String("name", name), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (c *Controller) ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	res, err := c.repo.ListProductTypes(ctx)
+	if err != nil {
+		zap.L().Error("Failed to list product types", zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	res, err := c.repo.CreateProductType(ctx, req)
+	if err != nil {
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			zap.L().Debug("Product type already exists", zap.String("name", req.Name))
+			return nil, ErrTypeAlreadyExists
+		}
+		zap.L().Error("Failed to create product type", zap.String("name", req.Name), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	res, err := c.repo.SetProductTypeActive(ctx, name, active)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Product type not found", zap.String("name", name))
+			return nil, ErrTypeNotFound
+		}
+		zap.L().Error("Failed to update product type", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteProductType removes a product type that no product refers to. Types in use can only be deactivated.
+func (c *Controller) DeleteProductType(ctx context.Context, name string) error {
+	err := c.repo.DeleteProductType(ctx, name)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Product type not found", zap.String("name", name))
+			return ErrTypeNotFound
+		}
+		if errors.Is(err, repo.ErrInUse) {
+			zap.L().Debug("Product type is in use", zap.String("name", name))
+			return ErrTypeInUse
+		}
+		zap.L().Error("Failed to delete product type", zap.String("name", name), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -8,3 +8,40 @@ var ErrReceptionAlreadyClosed = errors.New("reception already closed")
 var ErrNoItems = errors.New("no items")
 var ErrReceptionStillOpen = errors.New("reception still open")
 var ErrNoActiveReception = errors.New("no active reception")
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrReceptionNotFound = errors.New("reception not found")
+var ErrCityNotFound = errors.New("city not found")
+var ErrCityAlreadyExists = errors.New("city already exists")
+var ErrCityInUse = errors.New("city is in use, deactivate it instead")
+var ErrTypeNotFound = errors.New("product type not found")
+var ErrTypeAlreadyExists = errors.New("product type already exists")
+var ErrTypeInUse = errors.New("product type is in use, deactivate it instead")
+var ErrPVZForbidden = errors.New("employee is not assigned to this pvz")
+var ErrEmployeeOrPVZNotFound = errors.New("employee or pvz not found")
+var ErrAssignmentNotFound = errors.New("assignment not found")
+var ErrNoDeletedItems = errors.New("no deleted items")
+var ErrProductNotFound = errors.New("product not found")
+var ErrInvalidBatchSize = errors.New("batch must contain from 1 to 500 items")
+var ErrScanTimeInFuture = errors.New("scan time is in the future")
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+var ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is still in progress")
+var ErrBarcodeIsNotValid = errors.New("barcode is not a valid EAN-13 or Code 128 value")
+var ErrBarcodeAlreadyExists = errors.New("product with this barcode is already in the reception")
+var ErrQuantityIsNotValid = errors.New("quantity must be positive")
+var ErrWeightIsNotValid = errors.New("weight must be positive")
+var ErrInvalidManifestSize = errors.New("manifest must contain from 1 to 1000 items")
+var ErrManifestItemIsNotValid = errors.New("manifest item must have a type or a barcode")
+var ErrManifestBarcodeConflict = errors.New("barcode is listed in the manifest with different types")
+var ErrManifestNotFound = errors.New("manifest not found")
+var ErrManifestDiscrepancies = errors.New("reception does not match its strict manifest")
+var ErrNewerReceptionExists = errors.New("pvz already has a newer reception")
+var ErrReopenWindowExpired = errors.New("reception was closed too long ago to be reopened")
+var ErrPVZNotFound = errors.New("pvz not found")
+var ErrPVZClosed = errors.New("pvz is closed")
+var ErrWorkingHoursIsNotValid = errors.New("working hours must list each day once and open before closing")
+var ErrNothingToUpdate = errors.New("nothing to update")
+var ErrTimezoneIsNotValid = errors.New("timezone must be a valid IANA time zone name")
+var ErrTimezoneRequired = errors.New("working hours require a timezone")
+var ErrCoordinatesIsNotValid = errors.New("coordinates are out of range")
+var ErrCapacityIsNotValid = errors.New("capacity must be positive")
+var ErrRadiusIsNotValid = errors.New("radius must be from 1 to 50000 meters")
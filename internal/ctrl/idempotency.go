@@ -0,0 +1,72 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"go.uber.org/zap"
+	"time"
+)
+
+// IdempotencyKeyTTL is how long a completed response is kept for replay.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// BeginIdempotent claims k for the user in ctx before the request is served. When the key was used before,
+// the stored response is returned for replay instead, as long as it belongs to the same request.
+func (c *Controller) BeginIdempotent(ctx context.Context, k *md.IdempotencyKey) (*md.IdempotencyKey, error) {
+	k.UserID = uidFromCtx(ctx)
+	k.ExpiresAt = time.Now().Add(IdempotencyKeyTTL)
+
+	claimed, err := c.repo.ClaimIdempotencyKey(ctx, k)
+	if err != nil {
+		zap.L().Error("Failed to claim idempotency key", zap.String("key", k.Key), zap.Error(err))
+		return nil, err
+	}
+
+	if claimed {
+		return nil, nil
+	}
+
+	res, err := c.repo.GetIdempotencyKey(ctx, k.UserID, k.Key)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Idempotency key was released concurrently", zap.String("key", k.Key))
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		zap.L().Error("Failed to get idempotency key", zap.String("key", k.Key), zap.Error(err))
+		return nil, err
+	}
+
+	if res.RequestHash != k.RequestHash {
+		zap.L().Debug("Idempotency key reused with a different request", zap.String("key", k.Key))
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	if !res.Completed {
+		zap.L().Debug("Request with idempotency key is in progress", zap.String("key", k.Key))
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	return res, nil
+}
+
+// CompleteIdempotent stores the response of the request k was claimed for.
+func (c *Controller) CompleteIdempotent(ctx context.Context, k *md.IdempotencyKey) error {
+	if err := c.repo.CompleteIdempotencyKey(ctx, k); err != nil {
+		zap.L().Error("Failed to store idempotent response", zap.String("key", k.Key), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseIdempotent forgets k without a response, so a request that failed on the server side can be retried.
+func (c *Controller) ReleaseIdempotent(ctx context.Context, k *md.IdempotencyKey) error {
+	if err := c.repo.ReleaseIdempotencyKey(ctx, k.UserID, k.Key); err != nil {
+		zap.L().Error("Failed to release idempotency key", zap.String("key", k.Key), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package ctrl
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"slices"
+)
+
+// MaxManifestItems limits the number of lines accepted in a single manifest.
+const MaxManifestItems = 1000
+
+// UploadManifest replaces the manifest of the reception, which must still be in progress.
+// In strict mode the reception cannot be closed until the accepted products match the manifest.
+func (c *Controller) UploadManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem) (*dto.Manifest, error) {
+	if len(items) == 0 || len(items) > MaxManifestItems {
+		return nil, ErrInvalidManifestSize
+	}
+
+	items, err := normalizeManifest(items)
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := c.getScopedReception(ctx, receptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reception.Status != "in_progress" {
+		zap.L().Debug("Reception already closed", zap.String("id", receptionID.String()))
+		return nil, ErrReceptionAlreadyClosed
+	}
+
+	res, err := c.repo.ReplaceManifest(ctx, receptionID, strict, items, uidFromCtx(ctx))
+	if err != nil {
+		if errors.Is(err, repo.ErrNoActiveReception) {
+			zap.L().Debug("Reception already closed", zap.String("id", receptionID.String()))
+			return nil, ErrReceptionAlreadyClosed
+		}
+		if errors.Is(err, repo.ErrTypeIsNotValid) {
+			zap.L().Debug("Manifest references unknown product type", zap.String("id", receptionID.String()))
+			return nil, ErrTypeIsNotValid
+		}
+		zap.L().Error("Failed to replace manifest", zap.String("id", receptionID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info(
+		"Manifest uploaded",
+		zap.String("id", receptionID.String()),
+		zap.Int("items", len(items)),
+		zap.Bool("strict", strict),
+		zap.String("uid", uidFromCtx(ctx).String()),
+	)
+	return res, nil
+}
+
+func (c *Controller) GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error) {
+	if _, err := c.getScopedReception(ctx, receptionID); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.GetManifest(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Manifest not found", zap.String("id", receptionID.String()))
+			return nil, ErrManifestNotFound
+		}
+		zap.L().Error("Failed to get manifest", zap.String("id", receptionID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetDiscrepancies compares the products accepted so far with the manifest of the reception.
+func (c *Controller) GetDiscrepancies(ctx context.Context, receptionID uuid.UUID) (*dto.DiscrepancyReport, error) {
+	if _, err := c.getScopedReception(ctx, receptionID); err != nil {
+		return nil, err
+	}
+
+	return c.discrepancies(ctx, receptionID)
+}
+
+// checkStrictManifest reconciles the reception with its manifest and refuses to close it while it differs
+// from a strict one. It runs inside the close transaction, after the reception is locked.
+func checkStrictManifest(m *dto.Manifest, counts []*md.ProductCount) (*dto.DiscrepancyReport, error) {
+	report := reconcile(m, counts)
+	if report.Strict && !report.Reconciled {
+		return report, fmt.Errorf(
+			"%w: %d missing, %d surplus, %d unexpected",
+			ErrManifestDiscrepancies, len(report.Missing), len(report.Surplus), len(report.Unexpected),
+		)
+	}
+	return report, nil
+}
+
+func (c *Controller) getScopedReception(ctx context.Context, receptionID uuid.UUID) (*md.Reception, error) {
+	reception, err := c.repo.GetReception(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Reception not found", zap.String("id", receptionID.String()))
+			return nil, ErrReceptionNotFound
+		}
+		zap.L().Error("Failed to get reception", zap.String("id", receptionID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	if err = c.checkScope(ctx, reception.PVZID); err != nil {
+		return nil, err
+	}
+	return reception, nil
+}
+
+func (c *Controller) discrepancies(ctx context.Context, receptionID uuid.UUID) (*dto.DiscrepancyReport, error) {
+	manifest, err := c.repo.GetManifest(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("Manifest not found", zap.String("id", receptionID.String()))
+			return nil, ErrManifestNotFound
+		}
+		zap.L().Error("Failed to get manifest", zap.String("id", receptionID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	counts, err := c.repo.GetReceptionProductCounts(ctx, receptionID)
+	if err != nil {
+		zap.L().Error("Failed to count reception products", zap.String("id", receptionID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return reconcile(manifest, counts), nil
+}
+
+// normalizeManifest validates the items and merges lines describing the same barcode or, for lines
+// without a barcode, the same type, so every product is matched against exactly one line.
+func normalizeManifest(items []dto.ManifestItem) ([]dto.ManifestItem, error) {
+	res := make([]dto.ManifestItem, 0, len(items))
+	byBarcode := make(map[string]int)
+	byType := make(map[string]int)
+	for _, item := range items {
+		if item.Type.Set && item.Type.Value == "" {
+			item.Type = dto.OptString{}
+		}
+		if !item.Type.Set && !item.Barcode.Set {
+			return nil, ErrManifestItemIsNotValid
+		}
+		if item.Quantity < 1 {
+			return nil, ErrQuantityIsNotValid
+		}
+
+		if item.Barcode.Set {
+			if err := validateBarcode(item.Barcode.Value); err != nil {
+				return nil, err
+			}
+
+			if i, ok := byBarcode[item.Barcode.Value]; ok {
+				if res[i].Type != item.Type {
+					return nil, ErrManifestBarcodeConflict
+				}
+				res[i].Quantity += item.Quantity
+				continue
+			}
+			byBarcode[item.Barcode.Value] = len(res)
+			res = append(res, item)
+			continue
+		}
+
+		if i, ok := byType[item.Type.Value]; ok {
+			res[i].Quantity += item.Quantity
+			continue
+		}
+		byType[item.Type.Value] = len(res)
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// reconcile matches the accepted products against the manifest. Products are matched by barcode when
+// the manifest lists it, the rest are matched by type against lines without a barcode. Products matching
+// no line are reported as unexpected.
+func reconcile(m *dto.Manifest, counts []*md.ProductCount) *dto.DiscrepancyReport {
+	barcodes := make(map[string]int)
+	types := make(map[string]int)
+	for _, item := range m.Items {
+		if item.Barcode.Set {
+			barcodes[item.Barcode.Value] = 0
+		} else {
+			types[item.Type.Value] = 0
+		}
+	}
+
+	res := &dto.DiscrepancyReport{
+		ReceptionId: m.ReceptionId,
+		Strict:      m.Strict,
+		Missing:     make([]dto.DiscrepancyLine, 0),
+		Surplus:     make([]dto.DiscrepancyLine, 0),
+		Unexpected:  make([]dto.DiscrepancyLine, 0),
+	}
+	for _, cnt := range counts {
+		if cnt.Barcode.Valid {
+			if _, ok := barcodes[cnt.Barcode.String]; ok {
+				barcodes[cnt.Barcode.String] += cnt.Quantity
+				continue
+			}
+		}
+		if _, ok := types[cnt.Type]; ok {
+			types[cnt.Type] += cnt.Quantity
+			continue
+		}
+
+		line := dto.DiscrepancyLine{Type: dto.NewOptString(cnt.Type), Actual: cnt.Quantity}
+		if cnt.Barcode.Valid {
+			line.Barcode = dto.NewOptString(cnt.Barcode.String)
+		}
+		res.Unexpected = append(res.Unexpected, line)
+	}
+
+	for _, item := range m.Items {
+		line := dto.DiscrepancyLine{Type: item.Type, Barcode: item.Barcode, Expected: item.Quantity}
+		if item.Barcode.Set {
+			line.Actual = barcodes[item.Barcode.Value]
+		} else {
+			line.Actual = types[item.Type.Value]
+		}
+
+		switch {
+		case line.Actual < line.Expected:
+			res.Missing = append(res.Missing, line)
+		case line.Actual > line.Expected:
+			res.Surplus = append(res.Surplus, line)
+		}
+	}
+
+	for _, lines := range [][]dto.DiscrepancyLine{res.Missing, res.Surplus, res.Unexpected} {
+		slices.SortFunc(
+			lines, func(a, b dto.DiscrepancyLine) int {
+				if c := cmp.Compare(a.Type.Value, b.Type.Value); c != 0 {
+					return c
+				}
+				return cmp.Compare(a.Barcode.Value, b.Barcode.Value)
+			},
+		)
+	}
+
+	res.Reconciled = len(res.Missing) == 0 && len(res.Surplus) == 0 && len(res.Unexpected) == 0
+	return res
+}
@@ -0,0 +1,268 @@
+package ctrl
+
+import (
+	"context"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"math"
+	"time"
+	// The runtime image has no zoneinfo database, so timezones are validated against the embedded one.
+	_ "time/tzdata"
+)
+
+// workingTimeLayout is the HH:MM format of opening and closing times.
+const workingTimeLayout = "15:04"
+
+const (
+	// MaxNearbyRadius limits the radius of the nearby search in meters.
+	MaxNearbyRadius = 50000
+	// DefaultNearbyLimit is the number of PVZs returned by the nearby search when no limit is given.
+	DefaultNearbyLimit = 20
+	// MaxNearbyLimit limits the number of PVZs returned by the nearby search.
+	MaxNearbyLimit = 100
+)
+
+// weekdays maps time.Weekday to the day names used in working hours.
+var weekdays = [...]dto.WorkingHoursItemDay{
+	time.Monday:    dto.WorkingHoursItemDayMon,
+	time.Tuesday:   dto.WorkingHoursItemDayTue,
+	time.Wednesday: dto.WorkingHoursItemDayWed,
+	time.Thursday:  dto.WorkingHoursItemDayThu,
+	time.Friday:    dto.WorkingHoursItemDayFri,
+	time.Saturday:  dto.WorkingHoursItemDaySat,
+	time.Sunday:    dto.WorkingHoursItemDaySun,
+}
+
+func (c *Controller) GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
+	res, err := c.repo.GetPVZByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("PVZ not found", zap.String("id", id.String()))
+			return nil, ErrPVZNotFound
+		}
+		zap.L().Error("Failed to get pvz", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindNearbyPVZ returns the active PVZs within radius meters of the point, nearest first. With openNow only
+// the PVZs working at the moment by their schedule are returned.
+func (c *Controller) FindNearbyPVZ(ctx context.Context, lat, lon, radius float64, openNow bool, limit int64) ([]*dto.NearbyPVZ, error) {
+	if err := validateCoordinates(lat, lon); err != nil {
+		return nil, err
+	}
+
+	if math.IsNaN(radius) || radius < 1 || radius > MaxNearbyRadius {
+		return nil, ErrRadiusIsNotValid
+	}
+
+	if limit < 1 {
+		limit = DefaultNearbyLimit
+	}
+	limit = min(limit, MaxNearbyLimit)
+
+	if !openNow {
+		return c.findNearbyPage(ctx, lat, lon, radius, nil, limit)
+	}
+
+	// The schedule is checked here, so the radius is walked page by page until enough open PVZs are found.
+	now := time.Now()
+	res := make([]*dto.NearbyPVZ, 0, limit)
+	var after *md.NearbyCursor
+	for {
+		page, err := c.findNearbyPage(ctx, lat, lon, radius, after, MaxNearbyLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range page {
+			if isOpenAt(&p.Pvz, now) {
+				res = append(res, p)
+				if int64(len(res)) == limit {
+					return res, nil
+				}
+			}
+		}
+
+		if len(page) < MaxNearbyLimit {
+			return res, nil
+		}
+		last := page[len(page)-1]
+		after = &md.NearbyCursor{Distance: last.Distance, ID: last.Pvz.ID.Value}
+	}
+}
+
+func (c *Controller) findNearbyPage(ctx context.Context, lat, lon, radius float64, after *md.NearbyCursor, limit int64) ([]*dto.NearbyPVZ, error) {
+	res, err := c.repo.FindNearbyPVZs(ctx, lat, lon, radius, after, limit)
+	if err != nil {
+		zap.L().Error(
+			"Failed to find nearby pvz",
+			zap.Float64("lat", lat),
+			zap.Float64("lon", lon),
+			zap.Float64("radius", radius),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdatePVZ changes the fields set in req. A closed PVZ cannot be changed.
+func (c *Controller) UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error) {
+	if !req.City.Set && !req.Name.Set && !req.Address.Set && req.WorkingHours == nil &&
+		!req.Timezone.Set && !req.Coordinates.Set && !req.Capacity.Set {
+		return nil, ErrNothingToUpdate
+	}
+
+	if err := validateWorkingHours(req.WorkingHours); err != nil {
+		return nil, err
+	}
+
+	if err := validateLocation(req.Timezone, req.Coordinates, req.Capacity); err != nil {
+		return nil, err
+	}
+
+	res, err := c.repo.UpdatePVZ(ctx, id, req)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("PVZ not found", zap.String("id", id.String()))
+			return nil, ErrPVZNotFound
+		}
+		if errors.Is(err, repo.ErrPVZClosed) {
+			zap.L().Debug("PVZ is closed", zap.String("id", id.String()))
+			return nil, ErrPVZClosed
+		}
+		if errors.Is(err, repo.ErrCityIsNotValid) {
+			zap.L().Debug("City is not valid", zap.String("city", req.City.Value))
+			return nil, ErrCityIsNotValid
+		}
+		if errors.Is(err, repo.ErrTimezoneRequired) {
+			zap.L().Debug("Working hours require a timezone", zap.String("id", id.String()))
+			return nil, ErrTimezoneRequired
+		}
+		zap.L().Error("Failed to update pvz", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("PVZ updated", zap.String("id", id.String()), zap.String("uid", uidFromCtx(ctx).String()))
+	return res, nil
+}
+
+// DecommissionPVZ closes the PVZ for good, no receptions can be opened in it afterwards.
+// The reception in progress has to be closed first.
+func (c *Controller) DecommissionPVZ(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
+	res, err := c.repo.DecommissionPVZ(ctx, id, uidFromCtx(ctx))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			zap.L().Debug("PVZ not found", zap.String("id", id.String()))
+			return nil, ErrPVZNotFound
+		}
+		if errors.Is(err, repo.ErrPVZClosed) {
+			zap.L().Debug("PVZ is already closed", zap.String("id", id.String()))
+			return nil, ErrPVZClosed
+		}
+		if errors.Is(err, repo.ErrReceptionStillOpen) {
+			zap.L().Debug("Reception still open", zap.String("id", id.String()))
+			return nil, ErrReceptionStillOpen
+		}
+		zap.L().Error("Failed to decommission pvz", zap.String("id", id.String()), zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("PVZ decommissioned", zap.String("id", id.String()), zap.String("uid", uidFromCtx(ctx).String()))
+	return res, nil
+}
+
+// validatePVZ checks the attributes of a new PVZ. A schedule is only meaningful together with its timezone.
+func validatePVZ(req *dto.PVZ) error {
+	if err := validateWorkingHours(req.WorkingHours); err != nil {
+		return err
+	}
+
+	if len(req.WorkingHours) > 0 && !req.Timezone.Set {
+		return ErrTimezoneRequired
+	}
+
+	return validateLocation(req.Timezone, req.Coordinates, req.Capacity)
+}
+
+// validateLocation checks the optional timezone, coordinates and capacity of a PVZ.
+func validateLocation(tz dto.OptString, coords dto.OptCoordinates, capacity dto.OptInt) error {
+	if tz.Set {
+		if tz.Value == "" || tz.Value == "Local" {
+			return ErrTimezoneIsNotValid
+		}
+		if _, err := time.LoadLocation(tz.Value); err != nil {
+			return ErrTimezoneIsNotValid
+		}
+	}
+
+	if coords.Set {
+		if err := validateCoordinates(coords.Value.Latitude, coords.Value.Longitude); err != nil {
+			return err
+		}
+	}
+
+	if capacity.Set && capacity.Value < 1 {
+		return ErrCapacityIsNotValid
+	}
+	return nil
+}
+
+func validateCoordinates(lat, lon float64) error {
+	if math.IsNaN(lat) || math.IsNaN(lon) || lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return ErrCoordinatesIsNotValid
+	}
+	return nil
+}
+
+// isOpenAt reports whether t falls into the working hours of the PVZ in its timezone.
+// A PVZ without a schedule or a timezone is never reported open.
+func isOpenAt(p *dto.PVZ, t time.Time) bool {
+	if !p.Timezone.Set || len(p.WorkingHours) == 0 {
+		return false
+	}
+
+	loc, err := time.LoadLocation(p.Timezone.Value)
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	day, now := weekdays[local.Weekday()], local.Format(workingTimeLayout)
+	for _, h := range p.WorkingHours {
+		if h.Day == day && h.Open <= now && now < h.Close {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWorkingHours accepts at most one entry per day, each opening before it closes on the same day.
+func validateWorkingHours(hours dto.WorkingHours) error {
+	days := make(map[dto.WorkingHoursItemDay]struct{}, len(hours))
+	for _, h := range hours {
+		if err := h.Day.Validate(); err != nil {
+			return ErrWorkingHoursIsNotValid
+		}
+		if _, ok := days[h.Day]; ok {
+			return ErrWorkingHoursIsNotValid
+		}
+		days[h.Day] = struct{}{}
+
+		open, err := time.Parse(workingTimeLayout, h.Open)
+		if err != nil {
+			return ErrWorkingHoursIsNotValid
+		}
+		closing, err := time.Parse(workingTimeLayout, h.Close)
+		if err != nil || !open.Before(closing) {
+			return ErrWorkingHoursIsNotValid
+		}
+	}
+	return nil
+}
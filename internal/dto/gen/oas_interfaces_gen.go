@@ -1,6 +1,26 @@
 // Code generated by ogen, DO NOT EDIT.
 package dto
 
+type AuditGetRes interface {
+	auditGetRes()
+}
+
+type CitiesGetRes interface {
+	citiesGetRes()
+}
+
+type CitiesNameDeleteRes interface {
+	citiesNameDeleteRes()
+}
+
+type CitiesNamePutRes interface {
+	citiesNamePutRes()
+}
+
+type CitiesPostRes interface {
+	citiesPostRes()
+}
+
 type DummyLoginPostRes interface {
 	dummyLoginPostRes()
 }
@@ -9,10 +29,46 @@ type LoginPostRes interface {
 	loginPostRes()
 }
 
+type LogoutPostRes interface {
+	logoutPostRes()
+}
+
+type ProductTypesGetRes interface {
+	productTypesGetRes()
+}
+
+type ProductTypesNameDeleteRes interface {
+	productTypesNameDeleteRes()
+}
+
+type ProductTypesNamePutRes interface {
+	productTypesNamePutRes()
+}
+
+type ProductTypesPostRes interface {
+	productTypesPostRes()
+}
+
+type ProductsBatchPostRes interface {
+	productsBatchPostRes()
+}
+
+type ProductsGetRes interface {
+	productsGetRes()
+}
+
 type ProductsPostRes interface {
 	productsPostRes()
 }
 
+type PvzGetRes interface {
+	pvzGetRes()
+}
+
+type PvzNearbyGetRes interface {
+	pvzNearbyGetRes()
+}
+
 type PvzPostRes interface {
 	pvzPostRes()
 }
@@ -21,14 +77,74 @@ type PvzPvzIdCloseLastReceptionPostRes interface {
 	pvzPvzIdCloseLastReceptionPostRes()
 }
 
+type PvzPvzIdDecommissionPostRes interface {
+	pvzPvzIdDecommissionPostRes()
+}
+
 type PvzPvzIdDeleteLastProductPostRes interface {
 	pvzPvzIdDeleteLastProductPostRes()
 }
 
+type PvzPvzIdGetRes interface {
+	pvzPvzIdGetRes()
+}
+
+type PvzPvzIdPatchRes interface {
+	pvzPvzIdPatchRes()
+}
+
+type PvzPvzIdUndoLastDeletePostRes interface {
+	pvzPvzIdUndoLastDeletePostRes()
+}
+
 type ReceptionsPostRes interface {
 	receptionsPostRes()
 }
 
+type ReceptionsReceptionIdDiscrepanciesGetRes interface {
+	receptionsReceptionIdDiscrepanciesGetRes()
+}
+
+type ReceptionsReceptionIdManifestGetRes interface {
+	receptionsReceptionIdManifestGetRes()
+}
+
+type ReceptionsReceptionIdManifestPutReq interface {
+	receptionsReceptionIdManifestPutReq()
+}
+
+type ReceptionsReceptionIdManifestPutRes interface {
+	receptionsReceptionIdManifestPutRes()
+}
+
+type ReceptionsReceptionIdProductsProductIdDeleteRes interface {
+	receptionsReceptionIdProductsProductIdDeleteRes()
+}
+
+type ReceptionsReceptionIdReopenPostRes interface {
+	receptionsReceptionIdReopenPostRes()
+}
+
+type ReceptionsReceptionIdSummaryGetRes interface {
+	receptionsReceptionIdSummaryGetRes()
+}
+
+type RefreshPostRes interface {
+	refreshPostRes()
+}
+
 type RegisterPostRes interface {
 	registerPostRes()
 }
+
+type UsersUserIdPvzGetRes interface {
+	usersUserIdPvzGetRes()
+}
+
+type UsersUserIdPvzPvzIdDeleteRes interface {
+	usersUserIdPvzPvzIdDeleteRes()
+}
+
+type UsersUserIdPvzPvzIdPutRes interface {
+	usersUserIdPvzPvzIdPutRes()
+}
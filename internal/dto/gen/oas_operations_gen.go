@@ -6,13 +6,41 @@ package dto
 type OperationName = string
 
 const (
-	DummyLoginPostOperation                 OperationName = "DummyLoginPost"
-	LoginPostOperation                      OperationName = "LoginPost"
-	ProductsPostOperation                   OperationName = "ProductsPost"
-	PvzGetOperation                         OperationName = "PvzGet"
-	PvzPostOperation                        OperationName = "PvzPost"
-	PvzPvzIdCloseLastReceptionPostOperation OperationName = "PvzPvzIdCloseLastReceptionPost"
-	PvzPvzIdDeleteLastProductPostOperation  OperationName = "PvzPvzIdDeleteLastProductPost"
-	ReceptionsPostOperation                 OperationName = "ReceptionsPost"
-	RegisterPostOperation                   OperationName = "RegisterPost"
+	AuditGetOperation                                     OperationName = "AuditGet"
+	CitiesGetOperation                                    OperationName = "CitiesGet"
+	CitiesNameDeleteOperation                             OperationName = "CitiesNameDelete"
+	CitiesNamePutOperation                                OperationName = "CitiesNamePut"
+	CitiesPostOperation                                   OperationName = "CitiesPost"
+	DummyLoginPostOperation                               OperationName = "DummyLoginPost"
+	LoginPostOperation                                    OperationName = "LoginPost"
+	LogoutPostOperation                                   OperationName = "LogoutPost"
+	ProductTypesGetOperation                              OperationName = "ProductTypesGet"
+	ProductTypesNameDeleteOperation                       OperationName = "ProductTypesNameDelete"
+	ProductTypesNamePutOperation                          OperationName = "ProductTypesNamePut"
+	ProductTypesPostOperation                             OperationName = "ProductTypesPost"
+	ProductsBatchPostOperation                            OperationName = "ProductsBatchPost"
+	ProductsGetOperation                                  OperationName = "ProductsGet"
+	ProductsPostOperation                                 OperationName = "ProductsPost"
+	PvzGetOperation                                       OperationName = "PvzGet"
+	PvzNearbyGetOperation                                 OperationName = "PvzNearbyGet"
+	PvzPostOperation                                      OperationName = "PvzPost"
+	PvzPvzIdCloseLastReceptionPostOperation               OperationName = "PvzPvzIdCloseLastReceptionPost"
+	PvzPvzIdDecommissionPostOperation                     OperationName = "PvzPvzIdDecommissionPost"
+	PvzPvzIdDeleteLastProductPostOperation                OperationName = "PvzPvzIdDeleteLastProductPost"
+	PvzPvzIdGetOperation                                  OperationName = "PvzPvzIdGet"
+	PvzPvzIdPatchOperation                                OperationName = "PvzPvzIdPatch"
+	PvzPvzIdUndoLastDeletePostOperation                   OperationName = "PvzPvzIdUndoLastDeletePost"
+	ReceptionsPostOperation                               OperationName = "ReceptionsPost"
+	ReceptionsReceptionIdDiscrepanciesGetOperation        OperationName = "ReceptionsReceptionIdDiscrepanciesGet"
+	ReceptionsReceptionIdManifestGetOperation             OperationName = "ReceptionsReceptionIdManifestGet"
+	ReceptionsReceptionIdManifestPutOperation             OperationName = "ReceptionsReceptionIdManifestPut"
+	ReceptionsReceptionIdProductsProductIdDeleteOperation OperationName = "ReceptionsReceptionIdProductsProductIdDelete"
+	ReceptionsReceptionIdReopenPostOperation              OperationName = "ReceptionsReceptionIdReopenPost"
+	ReceptionsReceptionIdSummaryGetOperation              OperationName = "ReceptionsReceptionIdSummaryGet"
+	RefreshPostOperation                                  OperationName = "RefreshPost"
+	RegisterPostOperation                                 OperationName = "RegisterPost"
+	UsersUserIdPvzGetOperation                            OperationName = "UsersUserIdPvzGet"
+	UsersUserIdPvzPvzIdDeleteOperation                    OperationName = "UsersUserIdPvzPvzIdDelete"
+	UsersUserIdPvzPvzIdPutOperation                       OperationName = "UsersUserIdPvzPvzIdPut"
+	WellKnownJwksJSONGetOperation                         OperationName = "WellKnownJwksJSONGet"
 )
@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,9 +61,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'd': // Prefix: "dummyLogin"
+			case '.': // Prefix: ".well-known/jwks.json"
 
-				if l := len("dummyLogin"); len(elem) >= l && elem[0:l] == "dummyLogin" {
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleWellKnownJwksJSONGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'a': // Prefix: "audit"
+
+				if l := len("audit"); len(elem) >= l && elem[0:l] == "audit" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,18 +92,76 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch r.Method {
+					case "GET":
+						s.handleAuditGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'c': // Prefix: "cities"
+
+				if l := len("cities"); len(elem) >= l && elem[0:l] == "cities" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleCitiesGetRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleDummyLoginPostRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleCitiesPostRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET,POST")
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "name"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleCitiesNameDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleCitiesNamePutRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,PUT")
+						}
+
+						return
+					}
+
+				}
 
-			case 'l': // Prefix: "login"
+			case 'd': // Prefix: "dummyLogin"
 
-				if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+				if l := len("dummyLogin"); len(elem) >= l && elem[0:l] == "dummyLogin" {
 					elem = elem[l:]
 				} else {
 					break
@@ -93,7 +171,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					// Leaf node.
 					switch r.Method {
 					case "POST":
-						s.handleLoginPostRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleDummyLoginPostRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "POST")
 					}
@@ -101,9 +179,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 
-			case 'p': // Prefix: "p"
+			case 'l': // Prefix: "log"
 
-				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
 					elem = elem[l:]
 				} else {
 					break
@@ -113,9 +191,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'r': // Prefix: "roducts"
+				case 'i': // Prefix: "in"
+
+					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleLoginPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
 
-					if l := len("roducts"); len(elem) >= l && elem[0:l] == "roducts" {
+				case 'o': // Prefix: "out"
+
+					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
 						elem = elem[l:]
 					} else {
 						break
@@ -125,7 +223,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "POST":
-							s.handleProductsPostRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleLogoutPostRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "POST")
 						}
@@ -133,6 +231,135 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 
+				}
+
+			case 'p': // Prefix: "p"
+
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'r': // Prefix: "roduct"
+
+					if l := len("roduct"); len(elem) >= l && elem[0:l] == "roduct" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '-': // Prefix: "-types"
+
+						if l := len("-types"); len(elem) >= l && elem[0:l] == "-types" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleProductTypesGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleProductTypesPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "name"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleProductTypesNameDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleProductTypesNamePutRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleProductsGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleProductsPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/batch"
+
+							if l := len("/batch"); len(elem) >= l && elem[0:l] == "/batch" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleProductsBatchPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
 				case 'v': // Prefix: "vz"
 
 					if l := len("vz"); len(elem) >= l && elem[0:l] == "vz" {
@@ -162,6 +389,32 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'n': // Prefix: "nearby"
+							origElem := elem
+							if l := len("nearby"); len(elem) >= l && elem[0:l] == "nearby" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handlePvzNearbyGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
 						// Param: "pvzId"
 						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
@@ -172,7 +425,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handlePvzPvzIdGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handlePvzPvzIdPatchRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PATCH")
+							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -209,9 +475,67 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'd': // Prefix: "delete_last_product"
+							case 'd': // Prefix: "de"
+
+								if l := len("de"); len(elem) >= l && elem[0:l] == "de" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "commission"
+
+									if l := len("commission"); len(elem) >= l && elem[0:l] == "commission" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePvzPvzIdDecommissionPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'l': // Prefix: "lete_last_product"
+
+									if l := len("lete_last_product"); len(elem) >= l && elem[0:l] == "lete_last_product" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePvzPvzIdDeleteLastProductPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case 'u': // Prefix: "undo_last_delete"
 
-								if l := len("delete_last_product"); len(elem) >= l && elem[0:l] == "delete_last_product" {
+								if l := len("undo_last_delete"); len(elem) >= l && elem[0:l] == "undo_last_delete" {
 									elem = elem[l:]
 								} else {
 									break
@@ -221,7 +545,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handlePvzPvzIdDeleteLastProductPostRequest([1]string{
+										s.handlePvzPvzIdUndoLastDeletePostRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -260,7 +584,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "POST":
 							s.handleReceptionsPostRequest([0]string{}, elemIsEscaped, w, r)
@@ -270,44 +593,310 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				case 'g': // Prefix: "gister"
-
-					if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleRegisterPostRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+						// Param: "receptionId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
 						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-						return
-					}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-				}
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-			}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "discrepancies"
 
-		}
-	}
-	s.notFound(w, r)
-}
+								if l := len("discrepancies"); len(elem) >= l && elem[0:l] == "discrepancies" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// Route is route object.
-type Route struct {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleReceptionsReceptionIdDiscrepanciesGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'm': // Prefix: "manifest"
+
+								if l := len("manifest"); len(elem) >= l && elem[0:l] == "manifest" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleReceptionsReceptionIdManifestGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleReceptionsReceptionIdManifestPutRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "products/"
+
+								if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "productId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleReceptionsReceptionIdProductsProductIdDeleteRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "reopen"
+
+								if l := len("reopen"); len(elem) >= l && elem[0:l] == "reopen" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReceptionsReceptionIdReopenPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 's': // Prefix: "summary"
+
+								if l := len("summary"); len(elem) >= l && elem[0:l] == "summary" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleReceptionsReceptionIdSummaryGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'f': // Prefix: "fresh"
+
+					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRefreshPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				case 'g': // Prefix: "gister"
+
+					if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRegisterPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				}
+
+			case 'u': // Prefix: "users/"
+
+				if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "userId"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/pvz"
+
+					if l := len("/pvz"); len(elem) >= l && elem[0:l] == "/pvz" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleUsersUserIdPvzGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "pvzId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleUsersUserIdPvzPvzIdDeleteRequest([2]string{
+									args[0],
+									args[1],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUsersUserIdPvzPvzIdPutRequest([2]string{
+									args[0],
+									args[1],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,PUT")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
 	name        string
 	summary     string
 	operationID string
 	pathPattern string
 	count       int
-	args        [1]string
+	args        [2]string
 }
 
 // Name returns ogen operation name.
@@ -387,9 +976,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'd': // Prefix: "dummyLogin"
+			case '.': // Prefix: ".well-known/jwks.json"
 
-				if l := len("dummyLogin"); len(elem) >= l && elem[0:l] == "dummyLogin" {
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = WellKnownJwksJSONGetOperation
+						r.summary = "Публичные ключи для проверки подписи токенов"
+						r.operationID = ""
+						r.pathPattern = "/.well-known/jwks.json"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'a': // Prefix: "audit"
+
+				if l := len("audit"); len(elem) >= l && elem[0:l] == "audit" {
 					elem = elem[l:]
 				} else {
 					break
@@ -398,11 +1011,42 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch method {
+					case "GET":
+						r.name = AuditGetOperation
+						r.summary = "Журнал изменений (только для модераторов)"
+						r.operationID = ""
+						r.pathPattern = "/audit"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'c': // Prefix: "cities"
+
+				if l := len("cities"); len(elem) >= l && elem[0:l] == "cities" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = CitiesGetOperation
+						r.summary = "Список городов (только для модераторов)"
+						r.operationID = ""
+						r.pathPattern = "/cities"
+						r.args = args
+						r.count = 0
+						return r, true
 					case "POST":
-						r.name = DummyLoginPostOperation
-						r.summary = "Получение тестового токена"
+						r.name = CitiesPostOperation
+						r.summary = "Добавление города в справочник (только для модераторов)"
 						r.operationID = ""
-						r.pathPattern = "/dummyLogin"
+						r.pathPattern = "/cities"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -410,10 +1054,53 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
-			case 'l': // Prefix: "login"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-				if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+					// Param: "name"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = CitiesNameDeleteOperation
+							r.summary = "Удаление города из справочника (только для модераторов)"
+							r.operationID = ""
+							r.pathPattern = "/cities/{name}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = CitiesNamePutOperation
+							r.summary = "Активация или деактивация города (только для модераторов)"
+							r.operationID = ""
+							r.pathPattern = "/cities/{name}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'd': // Prefix: "dummyLogin"
+
+				if l := len("dummyLogin"); len(elem) >= l && elem[0:l] == "dummyLogin" {
 					elem = elem[l:]
 				} else {
 					break
@@ -423,10 +1110,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					// Leaf node.
 					switch method {
 					case "POST":
-						r.name = LoginPostOperation
-						r.summary = "Авторизация пользователя"
+						r.name = DummyLoginPostOperation
+						r.summary = "Получение тестового токена"
 						r.operationID = ""
-						r.pathPattern = "/login"
+						r.pathPattern = "/dummyLogin"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -435,6 +1122,68 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
+			case 'l': // Prefix: "log"
+
+				if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'i': // Prefix: "in"
+
+					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = LoginPostOperation
+							r.summary = "Авторизация пользователя"
+							r.operationID = ""
+							r.pathPattern = "/login"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'o': // Prefix: "out"
+
+					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = LogoutPostOperation
+							r.summary = "Выход, отзыв текущего токена доступа и переданного refresh-токена"
+							r.operationID = ""
+							r.pathPattern = "/logout"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
 			case 'p': // Prefix: "p"
 
 				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
@@ -447,28 +1196,149 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'r': // Prefix: "roducts"
+				case 'r': // Prefix: "roduct"
 
-					if l := len("roducts"); len(elem) >= l && elem[0:l] == "roducts" {
+					if l := len("roduct"); len(elem) >= l && elem[0:l] == "roduct" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = ProductsPostOperation
-							r.summary = "Добавление товара в текущую приемку (только для сотрудников ПВЗ)"
-							r.operationID = ""
-							r.pathPattern = "/products"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '-': // Prefix: "-types"
+
+						if l := len("-types"); len(elem) >= l && elem[0:l] == "-types" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ProductTypesGetOperation
+								r.summary = "Список типов товаров (только для модераторов)"
+								r.operationID = ""
+								r.pathPattern = "/product-types"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = ProductTypesPostOperation
+								r.summary = "Добавление типа товара в справочник (только для модераторов)"
+								r.operationID = ""
+								r.pathPattern = "/product-types"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "name"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = ProductTypesNameDeleteOperation
+									r.summary = "Удаление типа товара из справочника (только для модераторов)"
+									r.operationID = ""
+									r.pathPattern = "/product-types/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = ProductTypesNamePutOperation
+									r.summary = "Активация или деактивация типа товара (только для модераторов)"
+									r.operationID = ""
+									r.pathPattern = "/product-types/{name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ProductsGetOperation
+								r.summary = "Поиск товаров по штрихкоду во всех приемках"
+								r.operationID = ""
+								r.pathPattern = "/products"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = ProductsPostOperation
+								r.summary = "Добавление товара в текущую приемку (только для сотрудников ПВЗ)"
+								r.operationID = ""
+								r.pathPattern = "/products"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/batch"
+
+							if l := len("/batch"); len(elem) >= l && elem[0:l] == "/batch" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = ProductsBatchPostOperation
+									r.summary = "Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)"
+									r.operationID = ""
+									r.pathPattern = "/products/batch"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
 						}
+
 					}
 
 				case 'v': // Prefix: "vz"
@@ -510,6 +1380,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'n': // Prefix: "nearby"
+							origElem := elem
+							if l := len("nearby"); len(elem) >= l && elem[0:l] == "nearby" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = PvzNearbyGetOperation
+									r.summary = "Поиск ближайших ПВЗ"
+									r.operationID = ""
+									r.pathPattern = "/pvz/nearby"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
 						// Param: "pvzId"
 						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
@@ -520,7 +1420,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = PvzPvzIdGetOperation
+								r.summary = "Получение ПВЗ"
+								r.operationID = ""
+								r.pathPattern = "/pvz/{pvzId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = PvzPvzIdPatchOperation
+								r.summary = "Изменение ПВЗ (только для модераторов)"
+								r.operationID = ""
+								r.pathPattern = "/pvz/{pvzId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -559,9 +1478,71 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
-							case 'd': // Prefix: "delete_last_product"
+							case 'd': // Prefix: "de"
+
+								if l := len("de"); len(elem) >= l && elem[0:l] == "de" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "commission"
+
+									if l := len("commission"); len(elem) >= l && elem[0:l] == "commission" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PvzPvzIdDecommissionPostOperation
+											r.summary = "Вывод ПВЗ из эксплуатации (только для модераторов)"
+											r.operationID = ""
+											r.pathPattern = "/pvz/{pvzId}/decommission"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'l': // Prefix: "lete_last_product"
+
+									if l := len("lete_last_product"); len(elem) >= l && elem[0:l] == "lete_last_product" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PvzPvzIdDeleteLastProductPostOperation
+											r.summary = "Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)"
+											r.operationID = ""
+											r.pathPattern = "/pvz/{pvzId}/delete_last_product"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'u': // Prefix: "undo_last_delete"
 
-								if l := len("delete_last_product"); len(elem) >= l && elem[0:l] == "delete_last_product" {
+								if l := len("undo_last_delete"); len(elem) >= l && elem[0:l] == "undo_last_delete" {
 									elem = elem[l:]
 								} else {
 									break
@@ -571,10 +1552,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									// Leaf node.
 									switch method {
 									case "POST":
-										r.name = PvzPvzIdDeleteLastProductPostOperation
-										r.summary = "Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)"
+										r.name = PvzPvzIdUndoLastDeletePostOperation
+										r.summary = "Восстановление последнего удаленного товара в текущей приемке (только для сотрудников ПВЗ)"
 										r.operationID = ""
-										r.pathPattern = "/pvz/{pvzId}/delete_last_product"
+										r.pathPattern = "/pvz/{pvzId}/undo_last_delete"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -612,7 +1593,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "POST":
 							r.name = ReceptionsPostOperation
@@ -626,6 +1606,206 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "receptionId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'd': // Prefix: "discrepancies"
+
+								if l := len("discrepancies"); len(elem) >= l && elem[0:l] == "discrepancies" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ReceptionsReceptionIdDiscrepanciesGetOperation
+										r.summary = "Сверка принятых товаров с манифестом приемки"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/discrepancies"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'm': // Prefix: "manifest"
+
+								if l := len("manifest"); len(elem) >= l && elem[0:l] == "manifest" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ReceptionsReceptionIdManifestGetOperation
+										r.summary = "Манифест приемки"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/manifest"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = ReceptionsReceptionIdManifestPutOperation
+										r.summary = "Загрузка манифеста открытой приемки (только для сотрудников ПВЗ)"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/manifest"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "products/"
+
+								if l := len("products/"); len(elem) >= l && elem[0:l] == "products/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "productId"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = ReceptionsReceptionIdProductsProductIdDeleteOperation
+										r.summary = "Удаление произвольного товара из открытой приемки (только для сотрудников ПВЗ)"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/products/{productId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "reopen"
+
+								if l := len("reopen"); len(elem) >= l && elem[0:l] == "reopen" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReceptionsReceptionIdReopenPostOperation
+										r.summary = "Повторное открытие закрытой приемки (только для модераторов)"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/reopen"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 's': // Prefix: "summary"
+
+								if l := len("summary"); len(elem) >= l && elem[0:l] == "summary" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ReceptionsReceptionIdSummaryGetOperation
+										r.summary = "Сводка по приемке товаров"
+										r.operationID = ""
+										r.pathPattern = "/receptions/{receptionId}/summary"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'f': // Prefix: "fresh"
+
+					if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = RefreshPostOperation
+							r.summary = "Обновление токена доступа по refresh-токену"
+							r.operationID = ""
+							r.pathPattern = "/refresh"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 
 				case 'g': // Prefix: "gister"
 
@@ -653,6 +1833,95 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
+			case 'u': // Prefix: "users/"
+
+				if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "userId"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/pvz"
+
+					if l := len("/pvz"); len(elem) >= l && elem[0:l] == "/pvz" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = UsersUserIdPvzGetOperation
+							r.summary = "Список ПВЗ, закрепленных за сотрудником (только для модераторов)"
+							r.operationID = ""
+							r.pathPattern = "/users/{userId}/pvz"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "pvzId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = UsersUserIdPvzPvzIdDeleteOperation
+								r.summary = "Открепление сотрудника от ПВЗ (только для модераторов)"
+								r.operationID = ""
+								r.pathPattern = "/users/{userId}/pvz/{pvzId}"
+								r.args = args
+								r.count = 2
+								return r, true
+							case "PUT":
+								r.name = UsersUserIdPvzPvzIdPutOperation
+								r.summary = "Закрепление сотрудника за ПВЗ (только для модераторов)"
+								r.operationID = ""
+								r.pathPattern = "/users/{userId}/pvz/{pvzId}"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
 			}
 
 		}
@@ -3,12 +3,153 @@
 package dto
 
 import (
+	"io"
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 )
 
+// Ref: #/components/schemas/AuditEntry
+type AuditEntry struct {
+	ID          int64     `json:"id"`
+	ActorId     OptUUID   `json:"actorId"`
+	ActorRole   OptString `json:"actorRole"`
+	Action      string    `json:"action"`
+	PvzId       OptUUID   `json:"pvzId"`
+	ReceptionId OptUUID   `json:"receptionId"`
+	ProductId   OptUUID   `json:"productId"`
+	RequestId   OptString `json:"requestId"`
+	// Состояние сущности до изменения.
+	Before jx.Raw `json:"before"`
+	// Состояние сущности после изменения.
+	After     jx.Raw    `json:"after"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *AuditEntry) GetID() int64 {
+	return s.ID
+}
+
+// GetActorId returns the value of ActorId.
+func (s *AuditEntry) GetActorId() OptUUID {
+	return s.ActorId
+}
+
+// GetActorRole returns the value of ActorRole.
+func (s *AuditEntry) GetActorRole() OptString {
+	return s.ActorRole
+}
+
+// GetAction returns the value of Action.
+func (s *AuditEntry) GetAction() string {
+	return s.Action
+}
+
+// GetPvzId returns the value of PvzId.
+func (s *AuditEntry) GetPvzId() OptUUID {
+	return s.PvzId
+}
+
+// GetReceptionId returns the value of ReceptionId.
+func (s *AuditEntry) GetReceptionId() OptUUID {
+	return s.ReceptionId
+}
+
+// GetProductId returns the value of ProductId.
+func (s *AuditEntry) GetProductId() OptUUID {
+	return s.ProductId
+}
+
+// GetRequestId returns the value of RequestId.
+func (s *AuditEntry) GetRequestId() OptString {
+	return s.RequestId
+}
+
+// GetBefore returns the value of Before.
+func (s *AuditEntry) GetBefore() jx.Raw {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *AuditEntry) GetAfter() jx.Raw {
+	return s.After
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AuditEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *AuditEntry) SetID(val int64) {
+	s.ID = val
+}
+
+// SetActorId sets the value of ActorId.
+func (s *AuditEntry) SetActorId(val OptUUID) {
+	s.ActorId = val
+}
+
+// SetActorRole sets the value of ActorRole.
+func (s *AuditEntry) SetActorRole(val OptString) {
+	s.ActorRole = val
+}
+
+// SetAction sets the value of Action.
+func (s *AuditEntry) SetAction(val string) {
+	s.Action = val
+}
+
+// SetPvzId sets the value of PvzId.
+func (s *AuditEntry) SetPvzId(val OptUUID) {
+	s.PvzId = val
+}
+
+// SetReceptionId sets the value of ReceptionId.
+func (s *AuditEntry) SetReceptionId(val OptUUID) {
+	s.ReceptionId = val
+}
+
+// SetProductId sets the value of ProductId.
+func (s *AuditEntry) SetProductId(val OptUUID) {
+	s.ProductId = val
+}
+
+// SetRequestId sets the value of RequestId.
+func (s *AuditEntry) SetRequestId(val OptString) {
+	s.RequestId = val
+}
+
+// SetBefore sets the value of Before.
+func (s *AuditEntry) SetBefore(val jx.Raw) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *AuditEntry) SetAfter(val jx.Raw) {
+	s.After = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AuditEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type AuditGetBadRequest Error
+
+func (*AuditGetBadRequest) auditGetRes() {}
+
+type AuditGetForbidden Error
+
+func (*AuditGetForbidden) auditGetRes() {}
+
+type AuditGetOKApplicationJSON []AuditEntry
+
+func (*AuditGetOKApplicationJSON) auditGetRes() {}
+
 type BearerAuth struct {
 	Token string
 }
@@ -23,6 +164,264 @@ func (s *BearerAuth) SetToken(val string) {
 	s.Token = val
 }
 
+type CitiesGetOKApplicationJSON []DictionaryEntry
+
+func (*CitiesGetOKApplicationJSON) citiesGetRes() {}
+
+type CitiesNameDeleteConflict Error
+
+func (*CitiesNameDeleteConflict) citiesNameDeleteRes() {}
+
+type CitiesNameDeleteForbidden Error
+
+func (*CitiesNameDeleteForbidden) citiesNameDeleteRes() {}
+
+type CitiesNameDeleteNotFound Error
+
+func (*CitiesNameDeleteNotFound) citiesNameDeleteRes() {}
+
+// CitiesNameDeleteOK is response for CitiesNameDelete operation.
+type CitiesNameDeleteOK struct{}
+
+func (*CitiesNameDeleteOK) citiesNameDeleteRes() {}
+
+type CitiesNamePutBadRequest Error
+
+func (*CitiesNamePutBadRequest) citiesNamePutRes() {}
+
+type CitiesNamePutForbidden Error
+
+func (*CitiesNamePutForbidden) citiesNamePutRes() {}
+
+type CitiesNamePutNotFound Error
+
+func (*CitiesNamePutNotFound) citiesNamePutRes() {}
+
+type CitiesPostBadRequest Error
+
+func (*CitiesPostBadRequest) citiesPostRes() {}
+
+type CitiesPostConflict Error
+
+func (*CitiesPostConflict) citiesPostRes() {}
+
+type CitiesPostForbidden Error
+
+func (*CitiesPostForbidden) citiesPostRes() {}
+
+type CitiesPostUnprocessableEntity Error
+
+func (*CitiesPostUnprocessableEntity) citiesPostRes() {}
+
+// Ref: #/components/schemas/Coordinates
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *Coordinates) GetLatitude() float64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *Coordinates) GetLongitude() float64 {
+	return s.Longitude
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *Coordinates) SetLatitude(val float64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *Coordinates) SetLongitude(val float64) {
+	s.Longitude = val
+}
+
+// Ref: #/components/schemas/DictionaryEntry
+type DictionaryEntry struct {
+	Name      string      `json:"name"`
+	IsActive  OptBool     `json:"isActive"`
+	CreatedAt OptDateTime `json:"createdAt"`
+}
+
+// GetName returns the value of Name.
+func (s *DictionaryEntry) GetName() string {
+	return s.Name
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *DictionaryEntry) GetIsActive() OptBool {
+	return s.IsActive
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DictionaryEntry) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetName sets the value of Name.
+func (s *DictionaryEntry) SetName(val string) {
+	s.Name = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *DictionaryEntry) SetIsActive(val OptBool) {
+	s.IsActive = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DictionaryEntry) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+func (*DictionaryEntry) citiesNamePutRes()       {}
+func (*DictionaryEntry) citiesPostRes()          {}
+func (*DictionaryEntry) productTypesNamePutRes() {}
+func (*DictionaryEntry) productTypesPostRes()    {}
+
+// Ref: #/components/schemas/DictionaryEntryUpdate
+type DictionaryEntryUpdate struct {
+	IsActive bool `json:"isActive"`
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *DictionaryEntryUpdate) GetIsActive() bool {
+	return s.IsActive
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *DictionaryEntryUpdate) SetIsActive(val bool) {
+	s.IsActive = val
+}
+
+// Ref: #/components/schemas/DiscrepancyLine
+type DiscrepancyLine struct {
+	Type    OptString `json:"type"`
+	Barcode OptString `json:"barcode"`
+	// Количество по манифесту.
+	Expected int `json:"expected"`
+	// Количество принятых единиц.
+	Actual int `json:"actual"`
+}
+
+// GetType returns the value of Type.
+func (s *DiscrepancyLine) GetType() OptString {
+	return s.Type
+}
+
+// GetBarcode returns the value of Barcode.
+func (s *DiscrepancyLine) GetBarcode() OptString {
+	return s.Barcode
+}
+
+// GetExpected returns the value of Expected.
+func (s *DiscrepancyLine) GetExpected() int {
+	return s.Expected
+}
+
+// GetActual returns the value of Actual.
+func (s *DiscrepancyLine) GetActual() int {
+	return s.Actual
+}
+
+// SetType sets the value of Type.
+func (s *DiscrepancyLine) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetBarcode sets the value of Barcode.
+func (s *DiscrepancyLine) SetBarcode(val OptString) {
+	s.Barcode = val
+}
+
+// SetExpected sets the value of Expected.
+func (s *DiscrepancyLine) SetExpected(val int) {
+	s.Expected = val
+}
+
+// SetActual sets the value of Actual.
+func (s *DiscrepancyLine) SetActual(val int) {
+	s.Actual = val
+}
+
+// Ref: #/components/schemas/DiscrepancyReport
+type DiscrepancyReport struct {
+	ReceptionId uuid.UUID `json:"receptionId"`
+	Strict      bool      `json:"strict"`
+	// Принятые товары полностью совпадают с манифестом.
+	Reconciled bool `json:"reconciled"`
+	// Позиции манифеста, принятые в меньшем количестве.
+	Missing []DiscrepancyLine `json:"missing"`
+	// Позиции манифеста, принятые в большем количестве.
+	Surplus []DiscrepancyLine `json:"surplus"`
+	// Принятые товары, отсутствующие в манифесте.
+	Unexpected []DiscrepancyLine `json:"unexpected"`
+}
+
+// GetReceptionId returns the value of ReceptionId.
+func (s *DiscrepancyReport) GetReceptionId() uuid.UUID {
+	return s.ReceptionId
+}
+
+// GetStrict returns the value of Strict.
+func (s *DiscrepancyReport) GetStrict() bool {
+	return s.Strict
+}
+
+// GetReconciled returns the value of Reconciled.
+func (s *DiscrepancyReport) GetReconciled() bool {
+	return s.Reconciled
+}
+
+// GetMissing returns the value of Missing.
+func (s *DiscrepancyReport) GetMissing() []DiscrepancyLine {
+	return s.Missing
+}
+
+// GetSurplus returns the value of Surplus.
+func (s *DiscrepancyReport) GetSurplus() []DiscrepancyLine {
+	return s.Surplus
+}
+
+// GetUnexpected returns the value of Unexpected.
+func (s *DiscrepancyReport) GetUnexpected() []DiscrepancyLine {
+	return s.Unexpected
+}
+
+// SetReceptionId sets the value of ReceptionId.
+func (s *DiscrepancyReport) SetReceptionId(val uuid.UUID) {
+	s.ReceptionId = val
+}
+
+// SetStrict sets the value of Strict.
+func (s *DiscrepancyReport) SetStrict(val bool) {
+	s.Strict = val
+}
+
+// SetReconciled sets the value of Reconciled.
+func (s *DiscrepancyReport) SetReconciled(val bool) {
+	s.Reconciled = val
+}
+
+// SetMissing sets the value of Missing.
+func (s *DiscrepancyReport) SetMissing(val []DiscrepancyLine) {
+	s.Missing = val
+}
+
+// SetSurplus sets the value of Surplus.
+func (s *DiscrepancyReport) SetSurplus(val []DiscrepancyLine) {
+	s.Surplus = val
+}
+
+// SetUnexpected sets the value of Unexpected.
+func (s *DiscrepancyReport) SetUnexpected(val []DiscrepancyLine) {
+	s.Unexpected = val
+}
+
+func (*DiscrepancyReport) receptionsReceptionIdDiscrepanciesGetRes() {}
+
 type DummyLoginPostReq struct {
 	Role DummyLoginPostReqRole `json:"role"`
 }
@@ -93,123 +492,761 @@ func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*Error) dummyLoginPostRes() {}
-func (*Error) loginPostRes()      {}
-func (*Error) registerPostRes()   {}
+func (*Error) citiesGetRes()       {}
+func (*Error) dummyLoginPostRes()  {}
+func (*Error) loginPostRes()       {}
+func (*Error) productTypesGetRes() {}
+func (*Error) pvzGetRes()          {}
 
-type LoginPostReq struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// Ref: #/components/schemas/JWK
+type JWK struct {
+	Kty JWKKty    `json:"kty"`
+	Kid string    `json:"kid"`
+	Use string    `json:"use"`
+	Alg JWKAlg    `json:"alg"`
+	N   OptString `json:"n"`
+	E   OptString `json:"e"`
+	Crv OptString `json:"crv"`
+	X   OptString `json:"x"`
 }
 
-// GetEmail returns the value of Email.
-func (s *LoginPostReq) GetEmail() string {
-	return s.Email
+// GetKty returns the value of Kty.
+func (s *JWK) GetKty() JWKKty {
+	return s.Kty
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginPostReq) GetPassword() string {
-	return s.Password
+// GetKid returns the value of Kid.
+func (s *JWK) GetKid() string {
+	return s.Kid
 }
 
-// SetEmail sets the value of Email.
-func (s *LoginPostReq) SetEmail(val string) {
-	s.Email = val
+// GetUse returns the value of Use.
+func (s *JWK) GetUse() string {
+	return s.Use
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginPostReq) SetPassword(val string) {
-	s.Password = val
+// GetAlg returns the value of Alg.
+func (s *JWK) GetAlg() JWKAlg {
+	return s.Alg
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// GetN returns the value of N.
+func (s *JWK) GetN() OptString {
+	return s.N
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// GetE returns the value of E.
+func (s *JWK) GetE() OptString {
+	return s.E
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// GetCrv returns the value of Crv.
+func (s *JWK) GetCrv() OptString {
+	return s.Crv
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetX returns the value of X.
+func (s *JWK) GetX() OptString {
+	return s.X
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetKty sets the value of Kty.
+func (s *JWK) SetKty(val JWKKty) {
+	s.Kty = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetKid sets the value of Kid.
+func (s *JWK) SetKid(val string) {
+	s.Kid = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetUse sets the value of Use.
+func (s *JWK) SetUse(val string) {
+	s.Use = val
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// SetAlg sets the value of Alg.
+func (s *JWK) SetAlg(val JWKAlg) {
+	s.Alg = val
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetN sets the value of N.
+func (s *JWK) SetN(val OptString) {
+	s.N = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// SetE sets the value of E.
+func (s *JWK) SetE(val OptString) {
+	s.E = val
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetCrv sets the value of Crv.
+func (s *JWK) SetCrv(val OptString) {
+	s.Crv = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// SetX sets the value of X.
+func (s *JWK) SetX(val OptString) {
+	s.X = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
+type JWKAlg string
+
+const (
+	JWKAlgRS256 JWKAlg = "RS256"
+	JWKAlgEdDSA JWKAlg = "EdDSA"
+)
+
+// AllValues returns all JWKAlg values.
+func (JWKAlg) AllValues() []JWKAlg {
+	return []JWKAlg{
+		JWKAlgRS256,
+		JWKAlgEdDSA,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s JWKAlg) MarshalText() ([]byte, error) {
+	switch s {
+	case JWKAlgRS256:
+		return []byte(s), nil
+	case JWKAlgEdDSA:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JWKAlg) UnmarshalText(data []byte) error {
+	switch JWKAlg(data) {
+	case JWKAlgRS256:
+		*s = JWKAlgRS256
+		return nil
+	case JWKAlgEdDSA:
+		*s = JWKAlgEdDSA
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type JWKKty string
+
+const (
+	JWKKtyRSA JWKKty = "RSA"
+	JWKKtyOKP JWKKty = "OKP"
+)
+
+// AllValues returns all JWKKty values.
+func (JWKKty) AllValues() []JWKKty {
+	return []JWKKty{
+		JWKKtyRSA,
+		JWKKtyOKP,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s JWKKty) MarshalText() ([]byte, error) {
+	switch s {
+	case JWKKtyRSA:
+		return []byte(s), nil
+	case JWKKtyOKP:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JWKKty) UnmarshalText(data []byte) error {
+	switch JWKKty(data) {
+	case JWKKtyRSA:
+		*s = JWKKtyRSA
+		return nil
+	case JWKKtyOKP:
+		*s = JWKKtyOKP
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type LoginPostReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// GetEmail returns the value of Email.
+func (s *LoginPostReq) GetEmail() string {
+	return s.Email
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginPostReq) GetPassword() string {
+	return s.Password
+}
+
+// SetEmail sets the value of Email.
+func (s *LoginPostReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginPostReq) SetPassword(val string) {
+	s.Password = val
+}
+
+type LogoutPostBadRequest Error
+
+func (*LogoutPostBadRequest) logoutPostRes() {}
+
+type LogoutPostConflict Error
+
+func (*LogoutPostConflict) logoutPostRes() {}
+
+type LogoutPostForbidden Error
+
+func (*LogoutPostForbidden) logoutPostRes() {}
+
+// LogoutPostOK is response for LogoutPost operation.
+type LogoutPostOK struct{}
+
+func (*LogoutPostOK) logoutPostRes() {}
+
+type LogoutPostReq struct {
+	RefreshToken OptString `json:"refreshToken"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *LogoutPostReq) GetRefreshToken() OptString {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *LogoutPostReq) SetRefreshToken(val OptString) {
+	s.RefreshToken = val
+}
+
+type LogoutPostUnprocessableEntity Error
+
+func (*LogoutPostUnprocessableEntity) logoutPostRes() {}
+
+// Ref: #/components/schemas/Manifest
+type Manifest struct {
+	ReceptionId uuid.UUID `json:"receptionId"`
+	// Приемку нельзя закрыть, пока есть расхождения с
+	// манифестом.
+	Strict    bool           `json:"strict"`
+	Items     []ManifestItem `json:"items"`
+	CreatedAt OptDateTime    `json:"createdAt"`
+	// Сотрудник, загрузивший манифест.
+	CreatedBy OptUUID `json:"createdBy"`
+}
+
+// GetReceptionId returns the value of ReceptionId.
+func (s *Manifest) GetReceptionId() uuid.UUID {
+	return s.ReceptionId
+}
+
+// GetStrict returns the value of Strict.
+func (s *Manifest) GetStrict() bool {
+	return s.Strict
+}
+
+// GetItems returns the value of Items.
+func (s *Manifest) GetItems() []ManifestItem {
+	return s.Items
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Manifest) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Manifest) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// SetReceptionId sets the value of ReceptionId.
+func (s *Manifest) SetReceptionId(val uuid.UUID) {
+	s.ReceptionId = val
+}
+
+// SetStrict sets the value of Strict.
+func (s *Manifest) SetStrict(val bool) {
+	s.Strict = val
+}
+
+// SetItems sets the value of Items.
+func (s *Manifest) SetItems(val []ManifestItem) {
+	s.Items = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Manifest) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Manifest) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
+func (*Manifest) receptionsReceptionIdManifestGetRes() {}
+func (*Manifest) receptionsReceptionIdManifestPutRes() {}
+
+// Ожидаемая позиция приемки, должен быть указан тип или
+// штрихкод.
+// Ref: #/components/schemas/ManifestItem
+type ManifestItem struct {
+	// Тип товара из справочника /product-types.
+	Type OptString `json:"type"`
+	// Штрихкод EAN-13 или Code 128.
+	Barcode OptString `json:"barcode"`
+	// Ожидаемое количество единиц.
+	Quantity int `json:"quantity"`
+}
+
+// GetType returns the value of Type.
+func (s *ManifestItem) GetType() OptString {
+	return s.Type
+}
+
+// GetBarcode returns the value of Barcode.
+func (s *ManifestItem) GetBarcode() OptString {
+	return s.Barcode
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ManifestItem) GetQuantity() int {
+	return s.Quantity
+}
+
+// SetType sets the value of Type.
+func (s *ManifestItem) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetBarcode sets the value of Barcode.
+func (s *ManifestItem) SetBarcode(val OptString) {
+	s.Barcode = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *ManifestItem) SetQuantity(val int) {
+	s.Quantity = val
+}
+
+// Ref: #/components/schemas/ManifestUpload
+type ManifestUpload struct {
+	Items []ManifestItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *ManifestUpload) GetItems() []ManifestItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *ManifestUpload) SetItems(val []ManifestItem) {
+	s.Items = val
+}
+
+func (*ManifestUpload) receptionsReceptionIdManifestPutReq() {}
+
+// Ref: #/components/schemas/NearbyPVZ
+type NearbyPVZ struct {
+	Pvz PVZ `json:"pvz"`
+	// Расстояние до ПВЗ в метрах.
+	Distance float64 `json:"distance"`
+}
+
+// GetPvz returns the value of Pvz.
+func (s *NearbyPVZ) GetPvz() PVZ {
+	return s.Pvz
+}
+
+// GetDistance returns the value of Distance.
+func (s *NearbyPVZ) GetDistance() float64 {
+	return s.Distance
+}
+
+// SetPvz sets the value of Pvz.
+func (s *NearbyPVZ) SetPvz(val PVZ) {
+	s.Pvz = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *NearbyPVZ) SetDistance(val float64) {
+	s.Distance = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCoordinates returns new OptCoordinates with value set to v.
+func NewOptCoordinates(v Coordinates) OptCoordinates {
+	return OptCoordinates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCoordinates is optional Coordinates.
+type OptCoordinates struct {
+	Value Coordinates
+	Set   bool
+}
+
+// IsSet returns true if OptCoordinates was set.
+func (o OptCoordinates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCoordinates) Reset() {
+	var v Coordinates
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCoordinates) SetTo(v Coordinates) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCoordinates) Get() (v Coordinates, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCoordinates) Or(d Coordinates) Coordinates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDiscrepancyReport returns new OptDiscrepancyReport with value set to v.
+func NewOptDiscrepancyReport(v DiscrepancyReport) OptDiscrepancyReport {
+	return OptDiscrepancyReport{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDiscrepancyReport is optional DiscrepancyReport.
+type OptDiscrepancyReport struct {
+	Value DiscrepancyReport
+	Set   bool
+}
+
+// IsSet returns true if OptDiscrepancyReport was set.
+func (o OptDiscrepancyReport) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDiscrepancyReport) Reset() {
+	var v DiscrepancyReport
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDiscrepancyReport) SetTo(v DiscrepancyReport) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDiscrepancyReport) Get() (v DiscrepancyReport, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDiscrepancyReport) Or(d DiscrepancyReport) DiscrepancyReport {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLogoutPostReq returns new OptLogoutPostReq with value set to v.
+func NewOptLogoutPostReq(v LogoutPostReq) OptLogoutPostReq {
+	return OptLogoutPostReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLogoutPostReq is optional LogoutPostReq.
+type OptLogoutPostReq struct {
+	Value LogoutPostReq
+	Set   bool
+}
+
+// IsSet returns true if OptLogoutPostReq was set.
+func (o OptLogoutPostReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLogoutPostReq) Reset() {
+	var v LogoutPostReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLogoutPostReq) SetTo(v LogoutPostReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLogoutPostReq) Get() (v LogoutPostReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLogoutPostReq) Or(d LogoutPostReq) LogoutPostReq {
+	if v, ok := o.Get(); ok {
+		return v
 	}
 	return d
 }
@@ -222,30 +1259,214 @@ func NewOptPVZ(v PVZ) OptPVZ {
 	}
 }
 
-// OptPVZ is optional PVZ.
-type OptPVZ struct {
-	Value PVZ
+// OptPVZ is optional PVZ.
+type OptPVZ struct {
+	Value PVZ
+	Set   bool
+}
+
+// IsSet returns true if OptPVZ was set.
+func (o OptPVZ) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPVZ) Reset() {
+	var v PVZ
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPVZ) SetTo(v PVZ) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPVZ) Get() (v PVZ, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPVZ) Or(d PVZ) PVZ {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPVZStatus returns new OptPVZStatus with value set to v.
+func NewOptPVZStatus(v PVZStatus) OptPVZStatus {
+	return OptPVZStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPVZStatus is optional PVZStatus.
+type OptPVZStatus struct {
+	Value PVZStatus
+	Set   bool
+}
+
+// IsSet returns true if OptPVZStatus was set.
+func (o OptPVZStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPVZStatus) Reset() {
+	var v PVZStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPVZStatus) SetTo(v PVZStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPVZStatus) Get() (v PVZStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPVZStatus) Or(d PVZStatus) PVZStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProduct returns new OptProduct with value set to v.
+func NewOptProduct(v Product) OptProduct {
+	return OptProduct{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptProduct is optional Product.
+type OptProduct struct {
+	Value Product
+	Set   bool
+}
+
+// IsSet returns true if OptProduct was set.
+func (o OptProduct) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProduct) Reset() {
+	var v Product
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProduct) SetTo(v Product) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProduct) Get() (v Product, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProduct) Or(d Product) Product {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReception returns new OptReception with value set to v.
+func NewOptReception(v Reception) OptReception {
+	return OptReception{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReception is optional Reception.
+type OptReception struct {
+	Value Reception
+	Set   bool
+}
+
+// IsSet returns true if OptReception was set.
+func (o OptReception) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReception) Reset() {
+	var v Reception
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReception) SetTo(v Reception) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReception) Get() (v Reception, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReception) Or(d Reception) Reception {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReceptionSummary returns new OptReceptionSummary with value set to v.
+func NewOptReceptionSummary(v ReceptionSummary) OptReceptionSummary {
+	return OptReceptionSummary{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReceptionSummary is optional ReceptionSummary.
+type OptReceptionSummary struct {
+	Value ReceptionSummary
 	Set   bool
 }
 
-// IsSet returns true if OptPVZ was set.
-func (o OptPVZ) IsSet() bool { return o.Set }
+// IsSet returns true if OptReceptionSummary was set.
+func (o OptReceptionSummary) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptPVZ) Reset() {
-	var v PVZ
+func (o *OptReceptionSummary) Reset() {
+	var v ReceptionSummary
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptPVZ) SetTo(v PVZ) {
+func (o *OptReceptionSummary) SetTo(v ReceptionSummary) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptPVZ) Get() (v PVZ, ok bool) {
+func (o OptReceptionSummary) Get() (v ReceptionSummary, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -253,45 +1474,45 @@ func (o OptPVZ) Get() (v PVZ, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptPVZ) Or(d PVZ) PVZ {
+func (o OptReceptionSummary) Or(d ReceptionSummary) ReceptionSummary {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptReception returns new OptReception with value set to v.
-func NewOptReception(v Reception) OptReception {
-	return OptReception{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptReception is optional Reception.
-type OptReception struct {
-	Value Reception
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
 }
 
-// IsSet returns true if OptReception was set.
-func (o OptReception) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptReception) Reset() {
-	var v Reception
+func (o *OptString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptReception) SetTo(v Reception) {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptReception) Get() (v Reception, ok bool) {
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -299,7 +1520,7 @@ func (o OptReception) Get() (v Reception, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptReception) Or(d Reception) Reception {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -352,206 +1573,738 @@ func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	return d
 }
 
-// Ref: #/components/schemas/PVZ
-type PVZ struct {
-	ID               OptUUID     `json:"id"`
-	RegistrationDate OptDateTime `json:"registrationDate"`
-	City             PVZCity     `json:"city"`
+// Ref: #/components/schemas/PVZ
+type PVZ struct {
+	ID               OptUUID     `json:"id"`
+	RegistrationDate OptDateTime `json:"registrationDate"`
+	// Название города из справочника /cities.
+	City         string       `json:"city"`
+	Name         OptString    `json:"name"`
+	Address      OptString    `json:"address"`
+	WorkingHours WorkingHours `json:"workingHours"`
+	// Часовой пояс IANA, в котором заданы часы работы.
+	Timezone    OptString      `json:"timezone"`
+	Coordinates OptCoordinates `json:"coordinates"`
+	// Вместимость ПВЗ в товарах.
+	Capacity OptInt       `json:"capacity"`
+	Status   OptPVZStatus `json:"status"`
+	// Время вывода ПВЗ из эксплуатации.
+	ClosedAt OptDateTime `json:"closedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *PVZ) GetID() OptUUID {
+	return s.ID
+}
+
+// GetRegistrationDate returns the value of RegistrationDate.
+func (s *PVZ) GetRegistrationDate() OptDateTime {
+	return s.RegistrationDate
+}
+
+// GetCity returns the value of City.
+func (s *PVZ) GetCity() string {
+	return s.City
+}
+
+// GetName returns the value of Name.
+func (s *PVZ) GetName() OptString {
+	return s.Name
+}
+
+// GetAddress returns the value of Address.
+func (s *PVZ) GetAddress() OptString {
+	return s.Address
+}
+
+// GetWorkingHours returns the value of WorkingHours.
+func (s *PVZ) GetWorkingHours() WorkingHours {
+	return s.WorkingHours
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *PVZ) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// GetCoordinates returns the value of Coordinates.
+func (s *PVZ) GetCoordinates() OptCoordinates {
+	return s.Coordinates
+}
+
+// GetCapacity returns the value of Capacity.
+func (s *PVZ) GetCapacity() OptInt {
+	return s.Capacity
+}
+
+// GetStatus returns the value of Status.
+func (s *PVZ) GetStatus() OptPVZStatus {
+	return s.Status
+}
+
+// GetClosedAt returns the value of ClosedAt.
+func (s *PVZ) GetClosedAt() OptDateTime {
+	return s.ClosedAt
+}
+
+// SetID sets the value of ID.
+func (s *PVZ) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetRegistrationDate sets the value of RegistrationDate.
+func (s *PVZ) SetRegistrationDate(val OptDateTime) {
+	s.RegistrationDate = val
+}
+
+// SetCity sets the value of City.
+func (s *PVZ) SetCity(val string) {
+	s.City = val
+}
+
+// SetName sets the value of Name.
+func (s *PVZ) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetAddress sets the value of Address.
+func (s *PVZ) SetAddress(val OptString) {
+	s.Address = val
+}
+
+// SetWorkingHours sets the value of WorkingHours.
+func (s *PVZ) SetWorkingHours(val WorkingHours) {
+	s.WorkingHours = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *PVZ) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// SetCoordinates sets the value of Coordinates.
+func (s *PVZ) SetCoordinates(val OptCoordinates) {
+	s.Coordinates = val
+}
+
+// SetCapacity sets the value of Capacity.
+func (s *PVZ) SetCapacity(val OptInt) {
+	s.Capacity = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PVZ) SetStatus(val OptPVZStatus) {
+	s.Status = val
+}
+
+// SetClosedAt sets the value of ClosedAt.
+func (s *PVZ) SetClosedAt(val OptDateTime) {
+	s.ClosedAt = val
+}
+
+func (*PVZ) pvzPostRes()                  {}
+func (*PVZ) pvzPvzIdDecommissionPostRes() {}
+func (*PVZ) pvzPvzIdGetRes()              {}
+func (*PVZ) pvzPvzIdPatchRes()            {}
+
+// Ref: #/components/schemas/PVZAssignment
+type PVZAssignment struct {
+	UserId    uuid.UUID   `json:"userId"`
+	PvzId     uuid.UUID   `json:"pvzId"`
+	CreatedAt OptDateTime `json:"createdAt"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *PVZAssignment) GetUserId() uuid.UUID {
+	return s.UserId
+}
+
+// GetPvzId returns the value of PvzId.
+func (s *PVZAssignment) GetPvzId() uuid.UUID {
+	return s.PvzId
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PVZAssignment) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetUserId sets the value of UserId.
+func (s *PVZAssignment) SetUserId(val uuid.UUID) {
+	s.UserId = val
+}
+
+// SetPvzId sets the value of PvzId.
+func (s *PVZAssignment) SetPvzId(val uuid.UUID) {
+	s.PvzId = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PVZAssignment) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+func (*PVZAssignment) usersUserIdPvzPvzIdPutRes() {}
+
+// В закрытом ПВЗ нельзя открывать новые приемки.
+// Ref: #/components/schemas/PVZStatus
+type PVZStatus string
+
+const (
+	PVZStatusActive PVZStatus = "active"
+	PVZStatusClosed PVZStatus = "closed"
+)
+
+// AllValues returns all PVZStatus values.
+func (PVZStatus) AllValues() []PVZStatus {
+	return []PVZStatus{
+		PVZStatusActive,
+		PVZStatusClosed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PVZStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PVZStatusActive:
+		return []byte(s), nil
+	case PVZStatusClosed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PVZStatus) UnmarshalText(data []byte) error {
+	switch PVZStatus(data) {
+	case PVZStatusActive:
+		*s = PVZStatusActive
+		return nil
+	case PVZStatusClosed:
+		*s = PVZStatusClosed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Изменяются только переданные поля.
+// Ref: #/components/schemas/PVZUpdate
+type PVZUpdate struct {
+	City         OptString      `json:"city"`
+	Name         OptString      `json:"name"`
+	Address      OptString      `json:"address"`
+	WorkingHours WorkingHours   `json:"workingHours"`
+	Timezone     OptString      `json:"timezone"`
+	Coordinates  OptCoordinates `json:"coordinates"`
+	Capacity     OptInt         `json:"capacity"`
+}
+
+// GetCity returns the value of City.
+func (s *PVZUpdate) GetCity() OptString {
+	return s.City
+}
+
+// GetName returns the value of Name.
+func (s *PVZUpdate) GetName() OptString {
+	return s.Name
+}
+
+// GetAddress returns the value of Address.
+func (s *PVZUpdate) GetAddress() OptString {
+	return s.Address
+}
+
+// GetWorkingHours returns the value of WorkingHours.
+func (s *PVZUpdate) GetWorkingHours() WorkingHours {
+	return s.WorkingHours
+}
+
+// GetTimezone returns the value of Timezone.
+func (s *PVZUpdate) GetTimezone() OptString {
+	return s.Timezone
+}
+
+// GetCoordinates returns the value of Coordinates.
+func (s *PVZUpdate) GetCoordinates() OptCoordinates {
+	return s.Coordinates
+}
+
+// GetCapacity returns the value of Capacity.
+func (s *PVZUpdate) GetCapacity() OptInt {
+	return s.Capacity
+}
+
+// SetCity sets the value of City.
+func (s *PVZUpdate) SetCity(val OptString) {
+	s.City = val
+}
+
+// SetName sets the value of Name.
+func (s *PVZUpdate) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetAddress sets the value of Address.
+func (s *PVZUpdate) SetAddress(val OptString) {
+	s.Address = val
+}
+
+// SetWorkingHours sets the value of WorkingHours.
+func (s *PVZUpdate) SetWorkingHours(val WorkingHours) {
+	s.WorkingHours = val
+}
+
+// SetTimezone sets the value of Timezone.
+func (s *PVZUpdate) SetTimezone(val OptString) {
+	s.Timezone = val
+}
+
+// SetCoordinates sets the value of Coordinates.
+func (s *PVZUpdate) SetCoordinates(val OptCoordinates) {
+	s.Coordinates = val
+}
+
+// SetCapacity sets the value of Capacity.
+func (s *PVZUpdate) SetCapacity(val OptInt) {
+	s.Capacity = val
+}
+
+// Ref: #/components/schemas/Product
+type Product struct {
+	ID OptUUID `json:"id"`
+	// Время добавления товара на сервере, задает порядок
+	// товаров в приемке.
+	DateTime OptDateTime `json:"dateTime"`
+	// Время сканирования, переданное устройством.
+	ScannedAt   OptDateTime `json:"scannedAt"`
+	Type        string      `json:"type"`
+	ReceptionId uuid.UUID   `json:"receptionId"`
+	// Сотрудник, добавивший товар.
+	CreatedBy OptUUID `json:"createdBy"`
+	// Время удаления, отсутствует у неудаленных товаров.
+	DeletedAt OptDateTime `json:"deletedAt"`
+	// Сотрудник, удаливший товар.
+	DeletedBy OptUUID `json:"deletedBy"`
+	// Штрихкод EAN-13 или Code 128, уникален в рамках приемки.
+	Barcode OptString `json:"barcode"`
+	// Артикул поставщика.
+	Sku OptString `json:"sku"`
+	// Количество единиц, по умолчанию 1.
+	Quantity OptInt `json:"quantity"`
+	// Вес в килограммах.
+	Weight OptFloat64 `json:"weight"`
+}
+
+// GetID returns the value of ID.
+func (s *Product) GetID() OptUUID {
+	return s.ID
+}
+
+// GetDateTime returns the value of DateTime.
+func (s *Product) GetDateTime() OptDateTime {
+	return s.DateTime
+}
+
+// GetScannedAt returns the value of ScannedAt.
+func (s *Product) GetScannedAt() OptDateTime {
+	return s.ScannedAt
+}
+
+// GetType returns the value of Type.
+func (s *Product) GetType() string {
+	return s.Type
+}
+
+// GetReceptionId returns the value of ReceptionId.
+func (s *Product) GetReceptionId() uuid.UUID {
+	return s.ReceptionId
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Product) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *Product) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
+// GetDeletedBy returns the value of DeletedBy.
+func (s *Product) GetDeletedBy() OptUUID {
+	return s.DeletedBy
+}
+
+// GetBarcode returns the value of Barcode.
+func (s *Product) GetBarcode() OptString {
+	return s.Barcode
+}
+
+// GetSku returns the value of Sku.
+func (s *Product) GetSku() OptString {
+	return s.Sku
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *Product) GetQuantity() OptInt {
+	return s.Quantity
+}
+
+// GetWeight returns the value of Weight.
+func (s *Product) GetWeight() OptFloat64 {
+	return s.Weight
+}
+
+// SetID sets the value of ID.
+func (s *Product) SetID(val OptUUID) {
+	s.ID = val
+}
+
+// SetDateTime sets the value of DateTime.
+func (s *Product) SetDateTime(val OptDateTime) {
+	s.DateTime = val
+}
+
+// SetScannedAt sets the value of ScannedAt.
+func (s *Product) SetScannedAt(val OptDateTime) {
+	s.ScannedAt = val
+}
+
+// SetType sets the value of Type.
+func (s *Product) SetType(val string) {
+	s.Type = val
+}
+
+// SetReceptionId sets the value of ReceptionId.
+func (s *Product) SetReceptionId(val uuid.UUID) {
+	s.ReceptionId = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Product) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *Product) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+// SetDeletedBy sets the value of DeletedBy.
+func (s *Product) SetDeletedBy(val OptUUID) {
+	s.DeletedBy = val
+}
+
+// SetBarcode sets the value of Barcode.
+func (s *Product) SetBarcode(val OptString) {
+	s.Barcode = val
+}
+
+// SetSku sets the value of Sku.
+func (s *Product) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *Product) SetQuantity(val OptInt) {
+	s.Quantity = val
+}
+
+// SetWeight sets the value of Weight.
+func (s *Product) SetWeight(val OptFloat64) {
+	s.Weight = val
+}
+
+func (*Product) productsPostRes()               {}
+func (*Product) pvzPvzIdUndoLastDeletePostRes() {}
+
+// Ref: #/components/schemas/ProductBatch
+type ProductBatch struct {
+	Added   int64                `json:"added"`
+	Failed  int64                `json:"failed"`
+	Results []ProductBatchResult `json:"results"`
+}
+
+// GetAdded returns the value of Added.
+func (s *ProductBatch) GetAdded() int64 {
+	return s.Added
+}
+
+// GetFailed returns the value of Failed.
+func (s *ProductBatch) GetFailed() int64 {
+	return s.Failed
+}
+
+// GetResults returns the value of Results.
+func (s *ProductBatch) GetResults() []ProductBatchResult {
+	return s.Results
+}
+
+// SetAdded sets the value of Added.
+func (s *ProductBatch) SetAdded(val int64) {
+	s.Added = val
 }
 
-// GetID returns the value of ID.
-func (s *PVZ) GetID() OptUUID {
-	return s.ID
+// SetFailed sets the value of Failed.
+func (s *ProductBatch) SetFailed(val int64) {
+	s.Failed = val
 }
 
-// GetRegistrationDate returns the value of RegistrationDate.
-func (s *PVZ) GetRegistrationDate() OptDateTime {
-	return s.RegistrationDate
+// SetResults sets the value of Results.
+func (s *ProductBatch) SetResults(val []ProductBatchResult) {
+	s.Results = val
 }
 
-// GetCity returns the value of City.
-func (s *PVZ) GetCity() PVZCity {
-	return s.City
+func (*ProductBatch) productsBatchPostRes() {}
+
+// Ref: #/components/schemas/ProductBatchItem
+type ProductBatchItem struct {
+	// Тип товара из справочника /product-types.
+	Type string `json:"type"`
+	// Время сканирования на устройстве, хранится отдельно
+	// и не влияет на порядок товаров.
+	ScannedAt OptDateTime `json:"scannedAt"`
+	// Штрихкод EAN-13 или Code 128, уникален в рамках приемки.
+	Barcode OptString `json:"barcode"`
+	// Артикул поставщика.
+	Sku OptString `json:"sku"`
+	// Количество единиц, по умолчанию 1.
+	Quantity OptInt `json:"quantity"`
+	// Вес в килограммах.
+	Weight OptFloat64 `json:"weight"`
 }
 
-// SetID sets the value of ID.
-func (s *PVZ) SetID(val OptUUID) {
-	s.ID = val
+// GetType returns the value of Type.
+func (s *ProductBatchItem) GetType() string {
+	return s.Type
 }
 
-// SetRegistrationDate sets the value of RegistrationDate.
-func (s *PVZ) SetRegistrationDate(val OptDateTime) {
-	s.RegistrationDate = val
+// GetScannedAt returns the value of ScannedAt.
+func (s *ProductBatchItem) GetScannedAt() OptDateTime {
+	return s.ScannedAt
 }
 
-// SetCity sets the value of City.
-func (s *PVZ) SetCity(val PVZCity) {
-	s.City = val
+// GetBarcode returns the value of Barcode.
+func (s *ProductBatchItem) GetBarcode() OptString {
+	return s.Barcode
 }
 
-func (*PVZ) pvzPostRes() {}
+// GetSku returns the value of Sku.
+func (s *ProductBatchItem) GetSku() OptString {
+	return s.Sku
+}
 
-type PVZCity string
+// GetQuantity returns the value of Quantity.
+func (s *ProductBatchItem) GetQuantity() OptInt {
+	return s.Quantity
+}
 
-const (
-	PVZCity_0 PVZCity = "Москва"
-	PVZCity_1 PVZCity = "Санкт-Петербург"
-	PVZCity_2 PVZCity = "Казань"
-)
+// GetWeight returns the value of Weight.
+func (s *ProductBatchItem) GetWeight() OptFloat64 {
+	return s.Weight
+}
 
-// AllValues returns all PVZCity values.
-func (PVZCity) AllValues() []PVZCity {
-	return []PVZCity{
-		PVZCity_0,
-		PVZCity_1,
-		PVZCity_2,
-	}
+// SetType sets the value of Type.
+func (s *ProductBatchItem) SetType(val string) {
+	s.Type = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s PVZCity) MarshalText() ([]byte, error) {
-	switch s {
-	case PVZCity_0:
-		return []byte(s), nil
-	case PVZCity_1:
-		return []byte(s), nil
-	case PVZCity_2:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetScannedAt sets the value of ScannedAt.
+func (s *ProductBatchItem) SetScannedAt(val OptDateTime) {
+	s.ScannedAt = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PVZCity) UnmarshalText(data []byte) error {
-	switch PVZCity(data) {
-	case PVZCity_0:
-		*s = PVZCity_0
-		return nil
-	case PVZCity_1:
-		*s = PVZCity_1
-		return nil
-	case PVZCity_2:
-		*s = PVZCity_2
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetBarcode sets the value of Barcode.
+func (s *ProductBatchItem) SetBarcode(val OptString) {
+	s.Barcode = val
 }
 
-// Ref: #/components/schemas/Product
-type Product struct {
-	ID          OptUUID     `json:"id"`
-	DateTime    OptDateTime `json:"dateTime"`
-	Type        ProductType `json:"type"`
-	ReceptionId uuid.UUID   `json:"receptionId"`
+// SetSku sets the value of Sku.
+func (s *ProductBatchItem) SetSku(val OptString) {
+	s.Sku = val
 }
 
-// GetID returns the value of ID.
-func (s *Product) GetID() OptUUID {
-	return s.ID
+// SetQuantity sets the value of Quantity.
+func (s *ProductBatchItem) SetQuantity(val OptInt) {
+	s.Quantity = val
 }
 
-// GetDateTime returns the value of DateTime.
-func (s *Product) GetDateTime() OptDateTime {
-	return s.DateTime
+// SetWeight sets the value of Weight.
+func (s *ProductBatchItem) SetWeight(val OptFloat64) {
+	s.Weight = val
 }
 
-// GetType returns the value of Type.
-func (s *Product) GetType() ProductType {
-	return s.Type
+// Ref: #/components/schemas/ProductBatchResult
+type ProductBatchResult struct {
+	// Позиция товара в запросе.
+	Index   int        `json:"index"`
+	Product OptProduct `json:"product"`
+	// Причина, по которой товар не был добавлен.
+	Error OptString `json:"error"`
 }
 
-// GetReceptionId returns the value of ReceptionId.
-func (s *Product) GetReceptionId() uuid.UUID {
-	return s.ReceptionId
+// GetIndex returns the value of Index.
+func (s *ProductBatchResult) GetIndex() int {
+	return s.Index
 }
 
-// SetID sets the value of ID.
-func (s *Product) SetID(val OptUUID) {
-	s.ID = val
+// GetProduct returns the value of Product.
+func (s *ProductBatchResult) GetProduct() OptProduct {
+	return s.Product
 }
 
-// SetDateTime sets the value of DateTime.
-func (s *Product) SetDateTime(val OptDateTime) {
-	s.DateTime = val
+// GetError returns the value of Error.
+func (s *ProductBatchResult) GetError() OptString {
+	return s.Error
 }
 
-// SetType sets the value of Type.
-func (s *Product) SetType(val ProductType) {
-	s.Type = val
+// SetIndex sets the value of Index.
+func (s *ProductBatchResult) SetIndex(val int) {
+	s.Index = val
 }
 
-// SetReceptionId sets the value of ReceptionId.
-func (s *Product) SetReceptionId(val uuid.UUID) {
-	s.ReceptionId = val
+// SetProduct sets the value of Product.
+func (s *ProductBatchResult) SetProduct(val OptProduct) {
+	s.Product = val
 }
 
-func (*Product) productsPostRes() {}
+// SetError sets the value of Error.
+func (s *ProductBatchResult) SetError(val OptString) {
+	s.Error = val
+}
 
-type ProductType string
+type ProductTypesGetOKApplicationJSON []DictionaryEntry
 
-const (
-	ProductType_0 ProductType = "электроника"
-	ProductType_1 ProductType = "одежда"
-	ProductType_2 ProductType = "обувь"
-)
+func (*ProductTypesGetOKApplicationJSON) productTypesGetRes() {}
 
-// AllValues returns all ProductType values.
-func (ProductType) AllValues() []ProductType {
-	return []ProductType{
-		ProductType_0,
-		ProductType_1,
-		ProductType_2,
-	}
+type ProductTypesNameDeleteConflict Error
+
+func (*ProductTypesNameDeleteConflict) productTypesNameDeleteRes() {}
+
+type ProductTypesNameDeleteForbidden Error
+
+func (*ProductTypesNameDeleteForbidden) productTypesNameDeleteRes() {}
+
+type ProductTypesNameDeleteNotFound Error
+
+func (*ProductTypesNameDeleteNotFound) productTypesNameDeleteRes() {}
+
+// ProductTypesNameDeleteOK is response for ProductTypesNameDelete operation.
+type ProductTypesNameDeleteOK struct{}
+
+func (*ProductTypesNameDeleteOK) productTypesNameDeleteRes() {}
+
+type ProductTypesNamePutBadRequest Error
+
+func (*ProductTypesNamePutBadRequest) productTypesNamePutRes() {}
+
+type ProductTypesNamePutForbidden Error
+
+func (*ProductTypesNamePutForbidden) productTypesNamePutRes() {}
+
+type ProductTypesNamePutNotFound Error
+
+func (*ProductTypesNamePutNotFound) productTypesNamePutRes() {}
+
+type ProductTypesPostBadRequest Error
+
+func (*ProductTypesPostBadRequest) productTypesPostRes() {}
+
+type ProductTypesPostConflict Error
+
+func (*ProductTypesPostConflict) productTypesPostRes() {}
+
+type ProductTypesPostForbidden Error
+
+func (*ProductTypesPostForbidden) productTypesPostRes() {}
+
+type ProductTypesPostUnprocessableEntity Error
+
+func (*ProductTypesPostUnprocessableEntity) productTypesPostRes() {}
+
+type ProductsBatchPostBadRequest Error
+
+func (*ProductsBatchPostBadRequest) productsBatchPostRes() {}
+
+type ProductsBatchPostConflict Error
+
+func (*ProductsBatchPostConflict) productsBatchPostRes() {}
+
+type ProductsBatchPostForbidden Error
+
+func (*ProductsBatchPostForbidden) productsBatchPostRes() {}
+
+type ProductsBatchPostReq struct {
+	PvzId uuid.UUID          `json:"pvzId"`
+	Items []ProductBatchItem `json:"items"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ProductType) MarshalText() ([]byte, error) {
-	switch s {
-	case ProductType_0:
-		return []byte(s), nil
-	case ProductType_1:
-		return []byte(s), nil
-	case ProductType_2:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetPvzId returns the value of PvzId.
+func (s *ProductsBatchPostReq) GetPvzId() uuid.UUID {
+	return s.PvzId
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ProductType) UnmarshalText(data []byte) error {
-	switch ProductType(data) {
-	case ProductType_0:
-		*s = ProductType_0
-		return nil
-	case ProductType_1:
-		*s = ProductType_1
-		return nil
-	case ProductType_2:
-		*s = ProductType_2
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetItems returns the value of Items.
+func (s *ProductsBatchPostReq) GetItems() []ProductBatchItem {
+	return s.Items
+}
+
+// SetPvzId sets the value of PvzId.
+func (s *ProductsBatchPostReq) SetPvzId(val uuid.UUID) {
+	s.PvzId = val
+}
+
+// SetItems sets the value of Items.
+func (s *ProductsBatchPostReq) SetItems(val []ProductBatchItem) {
+	s.Items = val
 }
 
+type ProductsBatchPostUnprocessableEntity Error
+
+func (*ProductsBatchPostUnprocessableEntity) productsBatchPostRes() {}
+
+type ProductsGetBadRequest Error
+
+func (*ProductsGetBadRequest) productsGetRes() {}
+
+type ProductsGetForbidden Error
+
+func (*ProductsGetForbidden) productsGetRes() {}
+
+type ProductsGetOKApplicationJSON []Product
+
+func (*ProductsGetOKApplicationJSON) productsGetRes() {}
+
 type ProductsPostBadRequest Error
 
 func (*ProductsPostBadRequest) productsPostRes() {}
 
+type ProductsPostConflict Error
+
+func (*ProductsPostConflict) productsPostRes() {}
+
 type ProductsPostForbidden Error
 
 func (*ProductsPostForbidden) productsPostRes() {}
 
 type ProductsPostReq struct {
-	Type  ProductsPostReqType `json:"type"`
-	PvzId uuid.UUID           `json:"pvzId"`
+	// Тип товара из справочника /product-types.
+	Type  string    `json:"type"`
+	PvzId uuid.UUID `json:"pvzId"`
+	// Штрихкод EAN-13 или Code 128, уникален в рамках приемки.
+	Barcode OptString `json:"barcode"`
+	// Артикул поставщика.
+	Sku OptString `json:"sku"`
+	// Количество единиц, по умолчанию 1.
+	Quantity OptInt `json:"quantity"`
+	// Вес в килограммах.
+	Weight OptFloat64 `json:"weight"`
 }
 
 // GetType returns the value of Type.
-func (s *ProductsPostReq) GetType() ProductsPostReqType {
+func (s *ProductsPostReq) GetType() string {
 	return s.Type
 }
 
@@ -560,8 +2313,28 @@ func (s *ProductsPostReq) GetPvzId() uuid.UUID {
 	return s.PvzId
 }
 
+// GetBarcode returns the value of Barcode.
+func (s *ProductsPostReq) GetBarcode() OptString {
+	return s.Barcode
+}
+
+// GetSku returns the value of Sku.
+func (s *ProductsPostReq) GetSku() OptString {
+	return s.Sku
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ProductsPostReq) GetQuantity() OptInt {
+	return s.Quantity
+}
+
+// GetWeight returns the value of Weight.
+func (s *ProductsPostReq) GetWeight() OptFloat64 {
+	return s.Weight
+}
+
 // SetType sets the value of Type.
-func (s *ProductsPostReq) SetType(val ProductsPostReqType) {
+func (s *ProductsPostReq) SetType(val string) {
 	s.Type = val
 }
 
@@ -570,54 +2343,58 @@ func (s *ProductsPostReq) SetPvzId(val uuid.UUID) {
 	s.PvzId = val
 }
 
-type ProductsPostReqType string
+// SetBarcode sets the value of Barcode.
+func (s *ProductsPostReq) SetBarcode(val OptString) {
+	s.Barcode = val
+}
 
-const (
-	ProductsPostReqType_0 ProductsPostReqType = "электроника"
-	ProductsPostReqType_1 ProductsPostReqType = "одежда"
-	ProductsPostReqType_2 ProductsPostReqType = "обувь"
-)
+// SetSku sets the value of Sku.
+func (s *ProductsPostReq) SetSku(val OptString) {
+	s.Sku = val
+}
 
-// AllValues returns all ProductsPostReqType values.
-func (ProductsPostReqType) AllValues() []ProductsPostReqType {
-	return []ProductsPostReqType{
-		ProductsPostReqType_0,
-		ProductsPostReqType_1,
-		ProductsPostReqType_2,
-	}
+// SetQuantity sets the value of Quantity.
+func (s *ProductsPostReq) SetQuantity(val OptInt) {
+	s.Quantity = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ProductsPostReqType) MarshalText() ([]byte, error) {
-	switch s {
-	case ProductsPostReqType_0:
-		return []byte(s), nil
-	case ProductsPostReqType_1:
-		return []byte(s), nil
-	case ProductsPostReqType_2:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetWeight sets the value of Weight.
+func (s *ProductsPostReq) SetWeight(val OptFloat64) {
+	s.Weight = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ProductsPostReqType) UnmarshalText(data []byte) error {
-	switch ProductsPostReqType(data) {
-	case ProductsPostReqType_0:
-		*s = ProductsPostReqType_0
-		return nil
-	case ProductsPostReqType_1:
-		*s = ProductsPostReqType_1
-		return nil
-	case ProductsPostReqType_2:
-		*s = ProductsPostReqType_2
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+type ProductsPostUnprocessableEntity Error
+
+func (*ProductsPostUnprocessableEntity) productsPostRes() {}
+
+// PvzGetOKHeaders wraps []PvzGetOKItem with response headers.
+type PvzGetOKHeaders struct {
+	XNextCursor OptString
+	Response    []PvzGetOKItem
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *PvzGetOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *PvzGetOKHeaders) GetResponse() []PvzGetOKItem {
+	return s.Response
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *PvzGetOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PvzGetOKHeaders) SetResponse(val []PvzGetOKItem) {
+	s.Response = val
 }
 
+func (*PvzGetOKHeaders) pvzGetRes() {}
+
 type PvzGetOKItem struct {
 	Pvz        OptPVZ                       `json:"pvz"`
 	Receptions []PvzGetOKItemReceptionsItem `json:"receptions"`
@@ -668,26 +2445,78 @@ func (s *PvzGetOKItemReceptionsItem) SetProducts(val []Product) {
 	s.Products = val
 }
 
+type PvzNearbyGetBadRequest Error
+
+func (*PvzNearbyGetBadRequest) pvzNearbyGetRes() {}
+
+type PvzNearbyGetForbidden Error
+
+func (*PvzNearbyGetForbidden) pvzNearbyGetRes() {}
+
+type PvzNearbyGetOKApplicationJSON []NearbyPVZ
+
+func (*PvzNearbyGetOKApplicationJSON) pvzNearbyGetRes() {}
+
 type PvzPostBadRequest Error
 
 func (*PvzPostBadRequest) pvzPostRes() {}
 
+type PvzPostConflict Error
+
+func (*PvzPostConflict) pvzPostRes() {}
+
 type PvzPostForbidden Error
 
 func (*PvzPostForbidden) pvzPostRes() {}
 
+type PvzPostUnprocessableEntity Error
+
+func (*PvzPostUnprocessableEntity) pvzPostRes() {}
+
 type PvzPvzIdCloseLastReceptionPostBadRequest Error
 
 func (*PvzPvzIdCloseLastReceptionPostBadRequest) pvzPvzIdCloseLastReceptionPostRes() {}
 
+type PvzPvzIdCloseLastReceptionPostConflict Error
+
+func (*PvzPvzIdCloseLastReceptionPostConflict) pvzPvzIdCloseLastReceptionPostRes() {}
+
 type PvzPvzIdCloseLastReceptionPostForbidden Error
 
 func (*PvzPvzIdCloseLastReceptionPostForbidden) pvzPvzIdCloseLastReceptionPostRes() {}
 
+type PvzPvzIdCloseLastReceptionPostUnprocessableEntity Error
+
+func (*PvzPvzIdCloseLastReceptionPostUnprocessableEntity) pvzPvzIdCloseLastReceptionPostRes() {}
+
+type PvzPvzIdDecommissionPostBadRequest Error
+
+func (*PvzPvzIdDecommissionPostBadRequest) pvzPvzIdDecommissionPostRes() {}
+
+type PvzPvzIdDecommissionPostConflict Error
+
+func (*PvzPvzIdDecommissionPostConflict) pvzPvzIdDecommissionPostRes() {}
+
+type PvzPvzIdDecommissionPostForbidden Error
+
+func (*PvzPvzIdDecommissionPostForbidden) pvzPvzIdDecommissionPostRes() {}
+
+type PvzPvzIdDecommissionPostNotFound Error
+
+func (*PvzPvzIdDecommissionPostNotFound) pvzPvzIdDecommissionPostRes() {}
+
+type PvzPvzIdDecommissionPostUnprocessableEntity Error
+
+func (*PvzPvzIdDecommissionPostUnprocessableEntity) pvzPvzIdDecommissionPostRes() {}
+
 type PvzPvzIdDeleteLastProductPostBadRequest Error
 
 func (*PvzPvzIdDeleteLastProductPostBadRequest) pvzPvzIdDeleteLastProductPostRes() {}
 
+type PvzPvzIdDeleteLastProductPostConflict Error
+
+func (*PvzPvzIdDeleteLastProductPostConflict) pvzPvzIdDeleteLastProductPostRes() {}
+
 type PvzPvzIdDeleteLastProductPostForbidden Error
 
 func (*PvzPvzIdDeleteLastProductPostForbidden) pvzPvzIdDeleteLastProductPostRes() {}
@@ -697,12 +2526,72 @@ type PvzPvzIdDeleteLastProductPostOK struct{}
 
 func (*PvzPvzIdDeleteLastProductPostOK) pvzPvzIdDeleteLastProductPostRes() {}
 
+type PvzPvzIdDeleteLastProductPostUnprocessableEntity Error
+
+func (*PvzPvzIdDeleteLastProductPostUnprocessableEntity) pvzPvzIdDeleteLastProductPostRes() {}
+
+type PvzPvzIdGetBadRequest Error
+
+func (*PvzPvzIdGetBadRequest) pvzPvzIdGetRes() {}
+
+type PvzPvzIdGetNotFound Error
+
+func (*PvzPvzIdGetNotFound) pvzPvzIdGetRes() {}
+
+type PvzPvzIdPatchBadRequest Error
+
+func (*PvzPvzIdPatchBadRequest) pvzPvzIdPatchRes() {}
+
+type PvzPvzIdPatchConflict Error
+
+func (*PvzPvzIdPatchConflict) pvzPvzIdPatchRes() {}
+
+type PvzPvzIdPatchForbidden Error
+
+func (*PvzPvzIdPatchForbidden) pvzPvzIdPatchRes() {}
+
+type PvzPvzIdPatchNotFound Error
+
+func (*PvzPvzIdPatchNotFound) pvzPvzIdPatchRes() {}
+
+type PvzPvzIdPatchUnprocessableEntity Error
+
+func (*PvzPvzIdPatchUnprocessableEntity) pvzPvzIdPatchRes() {}
+
+type PvzPvzIdUndoLastDeletePostBadRequest Error
+
+func (*PvzPvzIdUndoLastDeletePostBadRequest) pvzPvzIdUndoLastDeletePostRes() {}
+
+type PvzPvzIdUndoLastDeletePostConflict Error
+
+func (*PvzPvzIdUndoLastDeletePostConflict) pvzPvzIdUndoLastDeletePostRes() {}
+
+type PvzPvzIdUndoLastDeletePostForbidden Error
+
+func (*PvzPvzIdUndoLastDeletePostForbidden) pvzPvzIdUndoLastDeletePostRes() {}
+
+type PvzPvzIdUndoLastDeletePostUnprocessableEntity Error
+
+func (*PvzPvzIdUndoLastDeletePostUnprocessableEntity) pvzPvzIdUndoLastDeletePostRes() {}
+
 // Ref: #/components/schemas/Reception
 type Reception struct {
 	ID       OptUUID         `json:"id"`
 	DateTime time.Time       `json:"dateTime"`
 	PvzId    uuid.UUID       `json:"pvzId"`
 	Status   ReceptionStatus `json:"status"`
+	// Сотрудник, открывший приемку.
+	CreatedBy OptUUID `json:"createdBy"`
+	// Пользователь, закрывший приемку.
+	ClosedBy OptUUID `json:"closedBy"`
+	// Время последнего повторного открытия приемки.
+	ReopenedAt OptDateTime `json:"reopenedAt"`
+	// Модератор, повторно открывший приемку.
+	ReopenedBy OptUUID `json:"reopenedBy"`
+	// Причина повторного открытия.
+	ReopenReason  OptString            `json:"reopenReason"`
+	Summary       OptReceptionSummary  `json:"summary"`
+	Discrepancies OptDiscrepancyReport `json:"discrepancies"`
 }
 
 // GetID returns the value of ID.
@@ -725,6 +2614,41 @@ func (s *Reception) GetStatus() ReceptionStatus {
 	return s.Status
 }
 
+// GetCreatedBy returns the value of CreatedBy.
+func (s *Reception) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// GetClosedBy returns the value of ClosedBy.
+func (s *Reception) GetClosedBy() OptUUID {
+	return s.ClosedBy
+}
+
+// GetReopenedAt returns the value of ReopenedAt.
+func (s *Reception) GetReopenedAt() OptDateTime {
+	return s.ReopenedAt
+}
+
+// GetReopenedBy returns the value of ReopenedBy.
+func (s *Reception) GetReopenedBy() OptUUID {
+	return s.ReopenedBy
+}
+
+// GetReopenReason returns the value of ReopenReason.
+func (s *Reception) GetReopenReason() OptString {
+	return s.ReopenReason
+}
+
+// GetSummary returns the value of Summary.
+func (s *Reception) GetSummary() OptReceptionSummary {
+	return s.Summary
+}
+
+// GetDiscrepancies returns the value of Discrepancies.
+func (s *Reception) GetDiscrepancies() OptDiscrepancyReport {
+	return s.Discrepancies
+}
+
 // SetID sets the value of ID.
 func (s *Reception) SetID(val OptUUID) {
 	s.ID = val
@@ -740,13 +2664,49 @@ func (s *Reception) SetPvzId(val uuid.UUID) {
 	s.PvzId = val
 }
 
-// SetStatus sets the value of Status.
-func (s *Reception) SetStatus(val ReceptionStatus) {
-	s.Status = val
+// SetStatus sets the value of Status.
+func (s *Reception) SetStatus(val ReceptionStatus) {
+	s.Status = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *Reception) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
+// SetClosedBy sets the value of ClosedBy.
+func (s *Reception) SetClosedBy(val OptUUID) {
+	s.ClosedBy = val
+}
+
+// SetReopenedAt sets the value of ReopenedAt.
+func (s *Reception) SetReopenedAt(val OptDateTime) {
+	s.ReopenedAt = val
+}
+
+// SetReopenedBy sets the value of ReopenedBy.
+func (s *Reception) SetReopenedBy(val OptUUID) {
+	s.ReopenedBy = val
+}
+
+// SetReopenReason sets the value of ReopenReason.
+func (s *Reception) SetReopenReason(val OptString) {
+	s.ReopenReason = val
+}
+
+// SetSummary sets the value of Summary.
+func (s *Reception) SetSummary(val OptReceptionSummary) {
+	s.Summary = val
+}
+
+// SetDiscrepancies sets the value of Discrepancies.
+func (s *Reception) SetDiscrepancies(val OptDiscrepancyReport) {
+	s.Discrepancies = val
 }
 
-func (*Reception) pvzPvzIdCloseLastReceptionPostRes() {}
-func (*Reception) receptionsPostRes()                 {}
+func (*Reception) pvzPvzIdCloseLastReceptionPostRes()  {}
+func (*Reception) receptionsPostRes()                  {}
+func (*Reception) receptionsReceptionIdReopenPostRes() {}
 
 type ReceptionStatus string
 
@@ -789,14 +2749,213 @@ func (s *ReceptionStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/ReceptionSummary
+type ReceptionSummary struct {
+	ReceptionId   uuid.UUID                           `json:"receptionId"`
+	PvzId         uuid.UUID                           `json:"pvzId"`
+	Status        string                              `json:"status"`
+	ProductCounts []ReceptionSummaryProductCountsItem `json:"productCounts"`
+	// Общее количество принятых единиц товара.
+	TotalProducts  int64       `json:"totalProducts"`
+	FirstProductAt OptDateTime `json:"firstProductAt"`
+	LastProductAt  OptDateTime `json:"lastProductAt"`
+	OpenedAt       time.Time   `json:"openedAt"`
+	// Сотрудник, открывший приемку.
+	CreatedBy       OptUUID     `json:"createdBy"`
+	ClosedAt        OptDateTime `json:"closedAt"`
+	DurationSeconds OptInt64    `json:"durationSeconds"`
+	ClosedBy        OptUUID     `json:"closedBy"`
+	// Приемка закрыта автоматически из-за отсутствия новых
+	// товаров.
+	AutoClosed OptBool `json:"autoClosed"`
+	// Причина автоматического закрытия.
+	CloseReason OptString `json:"closeReason"`
+}
+
+// GetReceptionId returns the value of ReceptionId.
+func (s *ReceptionSummary) GetReceptionId() uuid.UUID {
+	return s.ReceptionId
+}
+
+// GetPvzId returns the value of PvzId.
+func (s *ReceptionSummary) GetPvzId() uuid.UUID {
+	return s.PvzId
+}
+
+// GetStatus returns the value of Status.
+func (s *ReceptionSummary) GetStatus() string {
+	return s.Status
+}
+
+// GetProductCounts returns the value of ProductCounts.
+func (s *ReceptionSummary) GetProductCounts() []ReceptionSummaryProductCountsItem {
+	return s.ProductCounts
+}
+
+// GetTotalProducts returns the value of TotalProducts.
+func (s *ReceptionSummary) GetTotalProducts() int64 {
+	return s.TotalProducts
+}
+
+// GetFirstProductAt returns the value of FirstProductAt.
+func (s *ReceptionSummary) GetFirstProductAt() OptDateTime {
+	return s.FirstProductAt
+}
+
+// GetLastProductAt returns the value of LastProductAt.
+func (s *ReceptionSummary) GetLastProductAt() OptDateTime {
+	return s.LastProductAt
+}
+
+// GetOpenedAt returns the value of OpenedAt.
+func (s *ReceptionSummary) GetOpenedAt() time.Time {
+	return s.OpenedAt
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *ReceptionSummary) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// GetClosedAt returns the value of ClosedAt.
+func (s *ReceptionSummary) GetClosedAt() OptDateTime {
+	return s.ClosedAt
+}
+
+// GetDurationSeconds returns the value of DurationSeconds.
+func (s *ReceptionSummary) GetDurationSeconds() OptInt64 {
+	return s.DurationSeconds
+}
+
+// GetClosedBy returns the value of ClosedBy.
+func (s *ReceptionSummary) GetClosedBy() OptUUID {
+	return s.ClosedBy
+}
+
+// GetAutoClosed returns the value of AutoClosed.
+func (s *ReceptionSummary) GetAutoClosed() OptBool {
+	return s.AutoClosed
+}
+
+// GetCloseReason returns the value of CloseReason.
+func (s *ReceptionSummary) GetCloseReason() OptString {
+	return s.CloseReason
+}
+
+// SetReceptionId sets the value of ReceptionId.
+func (s *ReceptionSummary) SetReceptionId(val uuid.UUID) {
+	s.ReceptionId = val
+}
+
+// SetPvzId sets the value of PvzId.
+func (s *ReceptionSummary) SetPvzId(val uuid.UUID) {
+	s.PvzId = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReceptionSummary) SetStatus(val string) {
+	s.Status = val
+}
+
+// SetProductCounts sets the value of ProductCounts.
+func (s *ReceptionSummary) SetProductCounts(val []ReceptionSummaryProductCountsItem) {
+	s.ProductCounts = val
+}
+
+// SetTotalProducts sets the value of TotalProducts.
+func (s *ReceptionSummary) SetTotalProducts(val int64) {
+	s.TotalProducts = val
+}
+
+// SetFirstProductAt sets the value of FirstProductAt.
+func (s *ReceptionSummary) SetFirstProductAt(val OptDateTime) {
+	s.FirstProductAt = val
+}
+
+// SetLastProductAt sets the value of LastProductAt.
+func (s *ReceptionSummary) SetLastProductAt(val OptDateTime) {
+	s.LastProductAt = val
+}
+
+// SetOpenedAt sets the value of OpenedAt.
+func (s *ReceptionSummary) SetOpenedAt(val time.Time) {
+	s.OpenedAt = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *ReceptionSummary) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
+// SetClosedAt sets the value of ClosedAt.
+func (s *ReceptionSummary) SetClosedAt(val OptDateTime) {
+	s.ClosedAt = val
+}
+
+// SetDurationSeconds sets the value of DurationSeconds.
+func (s *ReceptionSummary) SetDurationSeconds(val OptInt64) {
+	s.DurationSeconds = val
+}
+
+// SetClosedBy sets the value of ClosedBy.
+func (s *ReceptionSummary) SetClosedBy(val OptUUID) {
+	s.ClosedBy = val
+}
+
+// SetAutoClosed sets the value of AutoClosed.
+func (s *ReceptionSummary) SetAutoClosed(val OptBool) {
+	s.AutoClosed = val
+}
+
+// SetCloseReason sets the value of CloseReason.
+func (s *ReceptionSummary) SetCloseReason(val OptString) {
+	s.CloseReason = val
+}
+
+func (*ReceptionSummary) receptionsReceptionIdSummaryGetRes() {}
+
+type ReceptionSummaryProductCountsItem struct {
+	Type string `json:"type"`
+	// Количество принятых единиц товара с учетом quantity.
+	Count int64 `json:"count"`
+}
+
+// GetType returns the value of Type.
+func (s *ReceptionSummaryProductCountsItem) GetType() string {
+	return s.Type
+}
+
+// GetCount returns the value of Count.
+func (s *ReceptionSummaryProductCountsItem) GetCount() int64 {
+	return s.Count
+}
+
+// SetType sets the value of Type.
+func (s *ReceptionSummaryProductCountsItem) SetType(val string) {
+	s.Type = val
+}
+
+// SetCount sets the value of Count.
+func (s *ReceptionSummaryProductCountsItem) SetCount(val int64) {
+	s.Count = val
+}
+
 type ReceptionsPostBadRequest Error
 
 func (*ReceptionsPostBadRequest) receptionsPostRes() {}
 
+type ReceptionsPostConflict Error
+
+func (*ReceptionsPostConflict) receptionsPostRes() {}
+
 type ReceptionsPostForbidden Error
 
 func (*ReceptionsPostForbidden) receptionsPostRes() {}
 
+type ReceptionsPostNotFound Error
+
+func (*ReceptionsPostNotFound) receptionsPostRes() {}
+
 type ReceptionsPostReq struct {
 	PvzId uuid.UUID `json:"pvzId"`
 }
@@ -811,6 +2970,159 @@ func (s *ReceptionsPostReq) SetPvzId(val uuid.UUID) {
 	s.PvzId = val
 }
 
+type ReceptionsPostUnprocessableEntity Error
+
+func (*ReceptionsPostUnprocessableEntity) receptionsPostRes() {}
+
+type ReceptionsReceptionIdDiscrepanciesGetBadRequest Error
+
+func (*ReceptionsReceptionIdDiscrepanciesGetBadRequest) receptionsReceptionIdDiscrepanciesGetRes() {}
+
+type ReceptionsReceptionIdDiscrepanciesGetForbidden Error
+
+func (*ReceptionsReceptionIdDiscrepanciesGetForbidden) receptionsReceptionIdDiscrepanciesGetRes() {}
+
+type ReceptionsReceptionIdDiscrepanciesGetNotFound Error
+
+func (*ReceptionsReceptionIdDiscrepanciesGetNotFound) receptionsReceptionIdDiscrepanciesGetRes() {}
+
+type ReceptionsReceptionIdManifestGetBadRequest Error
+
+func (*ReceptionsReceptionIdManifestGetBadRequest) receptionsReceptionIdManifestGetRes() {}
+
+type ReceptionsReceptionIdManifestGetForbidden Error
+
+func (*ReceptionsReceptionIdManifestGetForbidden) receptionsReceptionIdManifestGetRes() {}
+
+type ReceptionsReceptionIdManifestGetNotFound Error
+
+func (*ReceptionsReceptionIdManifestGetNotFound) receptionsReceptionIdManifestGetRes() {}
+
+type ReceptionsReceptionIdManifestPutBadRequest Error
+
+func (*ReceptionsReceptionIdManifestPutBadRequest) receptionsReceptionIdManifestPutRes() {}
+
+type ReceptionsReceptionIdManifestPutForbidden Error
+
+func (*ReceptionsReceptionIdManifestPutForbidden) receptionsReceptionIdManifestPutRes() {}
+
+type ReceptionsReceptionIdManifestPutNotFound Error
+
+func (*ReceptionsReceptionIdManifestPutNotFound) receptionsReceptionIdManifestPutRes() {}
+
+type ReceptionsReceptionIdManifestPutReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ReceptionsReceptionIdManifestPutReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ReceptionsReceptionIdManifestPutReqTextCsv) receptionsReceptionIdManifestPutReq() {}
+
+type ReceptionsReceptionIdProductsProductIdDeleteBadRequest Error
+
+func (*ReceptionsReceptionIdProductsProductIdDeleteBadRequest) receptionsReceptionIdProductsProductIdDeleteRes() {
+}
+
+type ReceptionsReceptionIdProductsProductIdDeleteForbidden Error
+
+func (*ReceptionsReceptionIdProductsProductIdDeleteForbidden) receptionsReceptionIdProductsProductIdDeleteRes() {
+}
+
+type ReceptionsReceptionIdProductsProductIdDeleteNotFound Error
+
+func (*ReceptionsReceptionIdProductsProductIdDeleteNotFound) receptionsReceptionIdProductsProductIdDeleteRes() {
+}
+
+// ReceptionsReceptionIdProductsProductIdDeleteOK is response for ReceptionsReceptionIdProductsProductIdDelete operation.
+type ReceptionsReceptionIdProductsProductIdDeleteOK struct{}
+
+func (*ReceptionsReceptionIdProductsProductIdDeleteOK) receptionsReceptionIdProductsProductIdDeleteRes() {
+}
+
+type ReceptionsReceptionIdReopenPostBadRequest Error
+
+func (*ReceptionsReceptionIdReopenPostBadRequest) receptionsReceptionIdReopenPostRes() {}
+
+type ReceptionsReceptionIdReopenPostConflict Error
+
+func (*ReceptionsReceptionIdReopenPostConflict) receptionsReceptionIdReopenPostRes() {}
+
+type ReceptionsReceptionIdReopenPostForbidden Error
+
+func (*ReceptionsReceptionIdReopenPostForbidden) receptionsReceptionIdReopenPostRes() {}
+
+type ReceptionsReceptionIdReopenPostNotFound Error
+
+func (*ReceptionsReceptionIdReopenPostNotFound) receptionsReceptionIdReopenPostRes() {}
+
+type ReceptionsReceptionIdReopenPostReq struct {
+	Reason string `json:"reason"`
+}
+
+// GetReason returns the value of Reason.
+func (s *ReceptionsReceptionIdReopenPostReq) GetReason() string {
+	return s.Reason
+}
+
+// SetReason sets the value of Reason.
+func (s *ReceptionsReceptionIdReopenPostReq) SetReason(val string) {
+	s.Reason = val
+}
+
+type ReceptionsReceptionIdReopenPostUnprocessableEntity Error
+
+func (*ReceptionsReceptionIdReopenPostUnprocessableEntity) receptionsReceptionIdReopenPostRes() {}
+
+type ReceptionsReceptionIdSummaryGetBadRequest Error
+
+func (*ReceptionsReceptionIdSummaryGetBadRequest) receptionsReceptionIdSummaryGetRes() {}
+
+type ReceptionsReceptionIdSummaryGetForbidden Error
+
+func (*ReceptionsReceptionIdSummaryGetForbidden) receptionsReceptionIdSummaryGetRes() {}
+
+type ReceptionsReceptionIdSummaryGetNotFound Error
+
+func (*ReceptionsReceptionIdSummaryGetNotFound) receptionsReceptionIdSummaryGetRes() {}
+
+type RefreshPostBadRequest Error
+
+func (*RefreshPostBadRequest) refreshPostRes() {}
+
+type RefreshPostReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshPostReq) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshPostReq) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type RefreshPostUnauthorized Error
+
+func (*RefreshPostUnauthorized) refreshPostRes() {}
+
+type RegisterPostBadRequest Error
+
+func (*RegisterPostBadRequest) registerPostRes() {}
+
+type RegisterPostConflict Error
+
+func (*RegisterPostConflict) registerPostRes() {}
+
 type RegisterPostReq struct {
 	Email    string              `json:"email"`
 	Password string              `json:"password"`
@@ -888,10 +3200,42 @@ func (s *RegisterPostReqRole) UnmarshalText(data []byte) error {
 	}
 }
 
+type RegisterPostUnprocessableEntity Error
+
+func (*RegisterPostUnprocessableEntity) registerPostRes() {}
+
 type Token string
 
 func (*Token) dummyLoginPostRes() {}
-func (*Token) loginPostRes()      {}
+
+// TokenIssuedHeaders wraps Token with response headers.
+type TokenIssuedHeaders struct {
+	XRefreshToken OptString
+	Response      Token
+}
+
+// GetXRefreshToken returns the value of XRefreshToken.
+func (s *TokenIssuedHeaders) GetXRefreshToken() OptString {
+	return s.XRefreshToken
+}
+
+// GetResponse returns the value of Response.
+func (s *TokenIssuedHeaders) GetResponse() Token {
+	return s.Response
+}
+
+// SetXRefreshToken sets the value of XRefreshToken.
+func (s *TokenIssuedHeaders) SetXRefreshToken(val OptString) {
+	s.XRefreshToken = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TokenIssuedHeaders) SetResponse(val Token) {
+	s.Response = val
+}
+
+func (*TokenIssuedHeaders) loginPostRes()   {}
+func (*TokenIssuedHeaders) refreshPostRes() {}
 
 // Ref: #/components/schemas/User
 type User struct {
@@ -972,3 +3316,172 @@ func (s *UserRole) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+type UsersUserIdPvzGetBadRequest Error
+
+func (*UsersUserIdPvzGetBadRequest) usersUserIdPvzGetRes() {}
+
+type UsersUserIdPvzGetForbidden Error
+
+func (*UsersUserIdPvzGetForbidden) usersUserIdPvzGetRes() {}
+
+type UsersUserIdPvzGetOKApplicationJSON []PVZAssignment
+
+func (*UsersUserIdPvzGetOKApplicationJSON) usersUserIdPvzGetRes() {}
+
+type UsersUserIdPvzPvzIdDeleteBadRequest Error
+
+func (*UsersUserIdPvzPvzIdDeleteBadRequest) usersUserIdPvzPvzIdDeleteRes() {}
+
+type UsersUserIdPvzPvzIdDeleteForbidden Error
+
+func (*UsersUserIdPvzPvzIdDeleteForbidden) usersUserIdPvzPvzIdDeleteRes() {}
+
+type UsersUserIdPvzPvzIdDeleteNotFound Error
+
+func (*UsersUserIdPvzPvzIdDeleteNotFound) usersUserIdPvzPvzIdDeleteRes() {}
+
+// UsersUserIdPvzPvzIdDeleteOK is response for UsersUserIdPvzPvzIdDelete operation.
+type UsersUserIdPvzPvzIdDeleteOK struct{}
+
+func (*UsersUserIdPvzPvzIdDeleteOK) usersUserIdPvzPvzIdDeleteRes() {}
+
+type UsersUserIdPvzPvzIdPutBadRequest Error
+
+func (*UsersUserIdPvzPvzIdPutBadRequest) usersUserIdPvzPvzIdPutRes() {}
+
+type UsersUserIdPvzPvzIdPutForbidden Error
+
+func (*UsersUserIdPvzPvzIdPutForbidden) usersUserIdPvzPvzIdPutRes() {}
+
+type UsersUserIdPvzPvzIdPutNotFound Error
+
+func (*UsersUserIdPvzPvzIdPutNotFound) usersUserIdPvzPvzIdPutRes() {}
+
+type WellKnownJwksJSONGetOK struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *WellKnownJwksJSONGetOK) GetKeys() []JWK {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *WellKnownJwksJSONGetOK) SetKeys(val []JWK) {
+	s.Keys = val
+}
+
+type WorkingHours []WorkingHoursItem
+
+type WorkingHoursItem struct {
+	Day   WorkingHoursItemDay `json:"day"`
+	Open  string              `json:"open"`
+	Close string              `json:"close"`
+}
+
+// GetDay returns the value of Day.
+func (s *WorkingHoursItem) GetDay() WorkingHoursItemDay {
+	return s.Day
+}
+
+// GetOpen returns the value of Open.
+func (s *WorkingHoursItem) GetOpen() string {
+	return s.Open
+}
+
+// GetClose returns the value of Close.
+func (s *WorkingHoursItem) GetClose() string {
+	return s.Close
+}
+
+// SetDay sets the value of Day.
+func (s *WorkingHoursItem) SetDay(val WorkingHoursItemDay) {
+	s.Day = val
+}
+
+// SetOpen sets the value of Open.
+func (s *WorkingHoursItem) SetOpen(val string) {
+	s.Open = val
+}
+
+// SetClose sets the value of Close.
+func (s *WorkingHoursItem) SetClose(val string) {
+	s.Close = val
+}
+
+type WorkingHoursItemDay string
+
+const (
+	WorkingHoursItemDayMon WorkingHoursItemDay = "mon"
+	WorkingHoursItemDayTue WorkingHoursItemDay = "tue"
+	WorkingHoursItemDayWed WorkingHoursItemDay = "wed"
+	WorkingHoursItemDayThu WorkingHoursItemDay = "thu"
+	WorkingHoursItemDayFri WorkingHoursItemDay = "fri"
+	WorkingHoursItemDaySat WorkingHoursItemDay = "sat"
+	WorkingHoursItemDaySun WorkingHoursItemDay = "sun"
+)
+
+// AllValues returns all WorkingHoursItemDay values.
+func (WorkingHoursItemDay) AllValues() []WorkingHoursItemDay {
+	return []WorkingHoursItemDay{
+		WorkingHoursItemDayMon,
+		WorkingHoursItemDayTue,
+		WorkingHoursItemDayWed,
+		WorkingHoursItemDayThu,
+		WorkingHoursItemDayFri,
+		WorkingHoursItemDaySat,
+		WorkingHoursItemDaySun,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WorkingHoursItemDay) MarshalText() ([]byte, error) {
+	switch s {
+	case WorkingHoursItemDayMon:
+		return []byte(s), nil
+	case WorkingHoursItemDayTue:
+		return []byte(s), nil
+	case WorkingHoursItemDayWed:
+		return []byte(s), nil
+	case WorkingHoursItemDayThu:
+		return []byte(s), nil
+	case WorkingHoursItemDayFri:
+		return []byte(s), nil
+	case WorkingHoursItemDaySat:
+		return []byte(s), nil
+	case WorkingHoursItemDaySun:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WorkingHoursItemDay) UnmarshalText(data []byte) error {
+	switch WorkingHoursItemDay(data) {
+	case WorkingHoursItemDayMon:
+		*s = WorkingHoursItemDayMon
+		return nil
+	case WorkingHoursItemDayTue:
+		*s = WorkingHoursItemDayTue
+		return nil
+	case WorkingHoursItemDayWed:
+		*s = WorkingHoursItemDayWed
+		return nil
+	case WorkingHoursItemDayThu:
+		*s = WorkingHoursItemDayThu
+		return nil
+	case WorkingHoursItemDayFri:
+		*s = WorkingHoursItemDayFri
+		return nil
+	case WorkingHoursItemDaySat:
+		*s = WorkingHoursItemDaySat
+		return nil
+	case WorkingHoursItemDaySun:
+		*s = WorkingHoursItemDaySun
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
@@ -8,6 +8,39 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AuditGet implements GET /audit operation.
+	//
+	// Журнал изменений (только для модераторов).
+	//
+	// GET /audit
+	AuditGet(ctx context.Context, params AuditGetParams) (AuditGetRes, error)
+	// CitiesGet implements GET /cities operation.
+	//
+	// Список городов (только для модераторов).
+	//
+	// GET /cities
+	CitiesGet(ctx context.Context) (CitiesGetRes, error)
+	// CitiesNameDelete implements DELETE /cities/{name} operation.
+	//
+	// Удаление города из справочника (только для
+	// модераторов).
+	//
+	// DELETE /cities/{name}
+	CitiesNameDelete(ctx context.Context, params CitiesNameDeleteParams) (CitiesNameDeleteRes, error)
+	// CitiesNamePut implements PUT /cities/{name} operation.
+	//
+	// Активация или деактивация города (только для
+	// модераторов).
+	//
+	// PUT /cities/{name}
+	CitiesNamePut(ctx context.Context, req *DictionaryEntryUpdate, params CitiesNamePutParams) (CitiesNamePutRes, error)
+	// CitiesPost implements POST /cities operation.
+	//
+	// Добавление города в справочник (только для
+	// модераторов).
+	//
+	// POST /cities
+	CitiesPost(ctx context.Context, req *DictionaryEntry, params CitiesPostParams) (CitiesPostRes, error)
 	// DummyLoginPost implements POST /dummyLogin operation.
 	//
 	// Получение тестового токена.
@@ -20,26 +53,81 @@ type Handler interface {
 	//
 	// POST /login
 	LoginPost(ctx context.Context, req *LoginPostReq) (LoginPostRes, error)
+	// LogoutPost implements POST /logout operation.
+	//
+	// Выход, отзыв текущего токена доступа и переданного
+	// refresh-токена.
+	//
+	// POST /logout
+	LogoutPost(ctx context.Context, req OptLogoutPostReq, params LogoutPostParams) (LogoutPostRes, error)
+	// ProductTypesGet implements GET /product-types operation.
+	//
+	// Список типов товаров (только для модераторов).
+	//
+	// GET /product-types
+	ProductTypesGet(ctx context.Context) (ProductTypesGetRes, error)
+	// ProductTypesNameDelete implements DELETE /product-types/{name} operation.
+	//
+	// Удаление типа товара из справочника (только для
+	// модераторов).
+	//
+	// DELETE /product-types/{name}
+	ProductTypesNameDelete(ctx context.Context, params ProductTypesNameDeleteParams) (ProductTypesNameDeleteRes, error)
+	// ProductTypesNamePut implements PUT /product-types/{name} operation.
+	//
+	// Активация или деактивация типа товара (только для
+	// модераторов).
+	//
+	// PUT /product-types/{name}
+	ProductTypesNamePut(ctx context.Context, req *DictionaryEntryUpdate, params ProductTypesNamePutParams) (ProductTypesNamePutRes, error)
+	// ProductTypesPost implements POST /product-types operation.
+	//
+	// Добавление типа товара в справочник (только для
+	// модераторов).
+	//
+	// POST /product-types
+	ProductTypesPost(ctx context.Context, req *DictionaryEntry, params ProductTypesPostParams) (ProductTypesPostRes, error)
+	// ProductsBatchPost implements POST /products/batch operation.
+	//
+	// Все товары добавляются в одной транзакции. Товары, не
+	// прошедшие проверку, не добавляются и не мешают
+	// добавлению остальных.
+	//
+	// POST /products/batch
+	ProductsBatchPost(ctx context.Context, req *ProductsBatchPostReq, params ProductsBatchPostParams) (ProductsBatchPostRes, error)
+	// ProductsGet implements GET /products operation.
+	//
+	// Поиск товаров по штрихкоду во всех приемках.
+	//
+	// GET /products
+	ProductsGet(ctx context.Context, params ProductsGetParams) (ProductsGetRes, error)
 	// ProductsPost implements POST /products operation.
 	//
 	// Добавление товара в текущую приемку (только для
 	// сотрудников ПВЗ).
 	//
 	// POST /products
-	ProductsPost(ctx context.Context, req *ProductsPostReq) (ProductsPostRes, error)
+	ProductsPost(ctx context.Context, req *ProductsPostReq, params ProductsPostParams) (ProductsPostRes, error)
 	// PvzGet implements GET /pvz operation.
 	//
 	// Получение списка ПВЗ с фильтрацией по дате приемки и
 	// пагинацией.
 	//
 	// GET /pvz
-	PvzGet(ctx context.Context, params PvzGetParams) ([]PvzGetOKItem, error)
+	PvzGet(ctx context.Context, params PvzGetParams) (PvzGetRes, error)
+	// PvzNearbyGet implements GET /pvz/nearby operation.
+	//
+	// Действующие ПВЗ с координатами в пределах радиуса,
+	// отсортированные по расстоянию.
+	//
+	// GET /pvz/nearby
+	PvzNearbyGet(ctx context.Context, params PvzNearbyGetParams) (PvzNearbyGetRes, error)
 	// PvzPost implements POST /pvz operation.
 	//
 	// Создание ПВЗ (только для модераторов).
 	//
 	// POST /pvz
-	PvzPost(ctx context.Context, req *PVZ) (PvzPostRes, error)
+	PvzPost(ctx context.Context, req *PVZ, params PvzPostParams) (PvzPostRes, error)
 	// PvzPvzIdCloseLastReceptionPost implements POST /pvz/{pvzId}/close_last_reception operation.
 	//
 	// Закрытие последней открытой приемки товаров в рамках
@@ -47,6 +135,13 @@ type Handler interface {
 	//
 	// POST /pvz/{pvzId}/close_last_reception
 	PvzPvzIdCloseLastReceptionPost(ctx context.Context, params PvzPvzIdCloseLastReceptionPostParams) (PvzPvzIdCloseLastReceptionPostRes, error)
+	// PvzPvzIdDecommissionPost implements POST /pvz/{pvzId}/decommission operation.
+	//
+	// ПВЗ переводится в статус closed, новые приемки в нем
+	// открывать нельзя.
+	//
+	// POST /pvz/{pvzId}/decommission
+	PvzPvzIdDecommissionPost(ctx context.Context, params PvzPvzIdDecommissionPostParams) (PvzPvzIdDecommissionPostRes, error)
 	// PvzPvzIdDeleteLastProductPost implements POST /pvz/{pvzId}/delete_last_product operation.
 	//
 	// Удаление последнего добавленного товара из текущей
@@ -54,19 +149,114 @@ type Handler interface {
 	//
 	// POST /pvz/{pvzId}/delete_last_product
 	PvzPvzIdDeleteLastProductPost(ctx context.Context, params PvzPvzIdDeleteLastProductPostParams) (PvzPvzIdDeleteLastProductPostRes, error)
+	// PvzPvzIdGet implements GET /pvz/{pvzId} operation.
+	//
+	// Получение ПВЗ.
+	//
+	// GET /pvz/{pvzId}
+	PvzPvzIdGet(ctx context.Context, params PvzPvzIdGetParams) (PvzPvzIdGetRes, error)
+	// PvzPvzIdPatch implements PATCH /pvz/{pvzId} operation.
+	//
+	// Изменение ПВЗ (только для модераторов).
+	//
+	// PATCH /pvz/{pvzId}
+	PvzPvzIdPatch(ctx context.Context, req *PVZUpdate, params PvzPvzIdPatchParams) (PvzPvzIdPatchRes, error)
+	// PvzPvzIdUndoLastDeletePost implements POST /pvz/{pvzId}/undo_last_delete operation.
+	//
+	// Восстановление последнего удаленного товара в
+	// текущей приемке (только для сотрудников ПВЗ).
+	//
+	// POST /pvz/{pvzId}/undo_last_delete
+	PvzPvzIdUndoLastDeletePost(ctx context.Context, params PvzPvzIdUndoLastDeletePostParams) (PvzPvzIdUndoLastDeletePostRes, error)
 	// ReceptionsPost implements POST /receptions operation.
 	//
 	// Создание новой приемки товаров (только для
 	// сотрудников ПВЗ).
 	//
 	// POST /receptions
-	ReceptionsPost(ctx context.Context, req *ReceptionsPostReq) (ReceptionsPostRes, error)
+	ReceptionsPost(ctx context.Context, req *ReceptionsPostReq, params ReceptionsPostParams) (ReceptionsPostRes, error)
+	// ReceptionsReceptionIdDiscrepanciesGet implements GET /receptions/{receptionId}/discrepancies operation.
+	//
+	// Сверка принятых товаров с манифестом приемки.
+	//
+	// GET /receptions/{receptionId}/discrepancies
+	ReceptionsReceptionIdDiscrepanciesGet(ctx context.Context, params ReceptionsReceptionIdDiscrepanciesGetParams) (ReceptionsReceptionIdDiscrepanciesGetRes, error)
+	// ReceptionsReceptionIdManifestGet implements GET /receptions/{receptionId}/manifest operation.
+	//
+	// Манифест приемки.
+	//
+	// GET /receptions/{receptionId}/manifest
+	ReceptionsReceptionIdManifestGet(ctx context.Context, params ReceptionsReceptionIdManifestGetParams) (ReceptionsReceptionIdManifestGetRes, error)
+	// ReceptionsReceptionIdManifestPut implements PUT /receptions/{receptionId}/manifest operation.
+	//
+	// Заменяет манифест приемки целиком. Позиции с
+	// одинаковым типом или штрихкодом суммируются.
+	// В формате CSV первая строка - заголовок с колонками type,
+	// barcode, quantity.
+	//
+	// PUT /receptions/{receptionId}/manifest
+	ReceptionsReceptionIdManifestPut(ctx context.Context, req ReceptionsReceptionIdManifestPutReq, params ReceptionsReceptionIdManifestPutParams) (ReceptionsReceptionIdManifestPutRes, error)
+	// ReceptionsReceptionIdProductsProductIdDelete implements DELETE /receptions/{receptionId}/products/{productId} operation.
+	//
+	// Удаление произвольного товара из открытой приемки
+	// (только для сотрудников ПВЗ).
+	//
+	// DELETE /receptions/{receptionId}/products/{productId}
+	ReceptionsReceptionIdProductsProductIdDelete(ctx context.Context, params ReceptionsReceptionIdProductsProductIdDeleteParams) (ReceptionsReceptionIdProductsProductIdDeleteRes, error)
+	// ReceptionsReceptionIdReopenPost implements POST /receptions/{receptionId}/reopen operation.
+	//
+	// Приемку можно открыть повторно, только если она
+	// последняя в ПВЗ и была закрыта не раньше, чем заданное
+	// в конфигурации окно. Причина сохраняется в истории
+	// приемки.
+	//
+	// POST /receptions/{receptionId}/reopen
+	ReceptionsReceptionIdReopenPost(ctx context.Context, req *ReceptionsReceptionIdReopenPostReq, params ReceptionsReceptionIdReopenPostParams) (ReceptionsReceptionIdReopenPostRes, error)
+	// ReceptionsReceptionIdSummaryGet implements GET /receptions/{receptionId}/summary operation.
+	//
+	// Сводка по приемке товаров.
+	//
+	// GET /receptions/{receptionId}/summary
+	ReceptionsReceptionIdSummaryGet(ctx context.Context, params ReceptionsReceptionIdSummaryGetParams) (ReceptionsReceptionIdSummaryGetRes, error)
+	// RefreshPost implements POST /refresh operation.
+	//
+	// Обновление токена доступа по refresh-токену.
+	//
+	// POST /refresh
+	RefreshPost(ctx context.Context, req *RefreshPostReq) (RefreshPostRes, error)
 	// RegisterPost implements POST /register operation.
 	//
 	// Регистрация пользователя.
 	//
 	// POST /register
-	RegisterPost(ctx context.Context, req *RegisterPostReq) (RegisterPostRes, error)
+	RegisterPost(ctx context.Context, req *RegisterPostReq, params RegisterPostParams) (RegisterPostRes, error)
+	// UsersUserIdPvzGet implements GET /users/{userId}/pvz operation.
+	//
+	// Список ПВЗ, закрепленных за сотрудником (только для
+	// модераторов).
+	//
+	// GET /users/{userId}/pvz
+	UsersUserIdPvzGet(ctx context.Context, params UsersUserIdPvzGetParams) (UsersUserIdPvzGetRes, error)
+	// UsersUserIdPvzPvzIdDelete implements DELETE /users/{userId}/pvz/{pvzId} operation.
+	//
+	// Открепление сотрудника от ПВЗ (только для
+	// модераторов).
+	//
+	// DELETE /users/{userId}/pvz/{pvzId}
+	UsersUserIdPvzPvzIdDelete(ctx context.Context, params UsersUserIdPvzPvzIdDeleteParams) (UsersUserIdPvzPvzIdDeleteRes, error)
+	// UsersUserIdPvzPvzIdPut implements PUT /users/{userId}/pvz/{pvzId} operation.
+	//
+	// Закрепление сотрудника за ПВЗ (только для
+	// модераторов).
+	//
+	// PUT /users/{userId}/pvz/{pvzId}
+	UsersUserIdPvzPvzIdPut(ctx context.Context, params UsersUserIdPvzPvzIdPutParams) (UsersUserIdPvzPvzIdPutRes, error)
+	// WellKnownJwksJSONGet implements GET /.well-known/jwks.json operation.
+	//
+	// Публичные ключи для проверки подписи токенов.
+	//
+	// GET /.well-known/jwks.json
+	WellKnownJwksJSONGet(ctx context.Context) (*WellKnownJwksJSONGetOK, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -13,6 +13,54 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AuditGet implements GET /audit operation.
+//
+// Журнал изменений (только для модераторов).
+//
+// GET /audit
+func (UnimplementedHandler) AuditGet(ctx context.Context, params AuditGetParams) (r AuditGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CitiesGet implements GET /cities operation.
+//
+// Список городов (только для модераторов).
+//
+// GET /cities
+func (UnimplementedHandler) CitiesGet(ctx context.Context) (r CitiesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CitiesNameDelete implements DELETE /cities/{name} operation.
+//
+// Удаление города из справочника (только для
+// модераторов).
+//
+// DELETE /cities/{name}
+func (UnimplementedHandler) CitiesNameDelete(ctx context.Context, params CitiesNameDeleteParams) (r CitiesNameDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CitiesNamePut implements PUT /cities/{name} operation.
+//
+// Активация или деактивация города (только для
+// модераторов).
+//
+// PUT /cities/{name}
+func (UnimplementedHandler) CitiesNamePut(ctx context.Context, req *DictionaryEntryUpdate, params CitiesNamePutParams) (r CitiesNamePutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CitiesPost implements POST /cities operation.
+//
+// Добавление города в справочник (только для
+// модераторов).
+//
+// POST /cities
+func (UnimplementedHandler) CitiesPost(ctx context.Context, req *DictionaryEntry, params CitiesPostParams) (r CitiesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DummyLoginPost implements POST /dummyLogin operation.
 //
 // Получение тестового токена.
@@ -31,13 +79,82 @@ func (UnimplementedHandler) LoginPost(ctx context.Context, req *LoginPostReq) (r
 	return r, ht.ErrNotImplemented
 }
 
+// LogoutPost implements POST /logout operation.
+//
+// Выход, отзыв текущего токена доступа и переданного
+// refresh-токена.
+//
+// POST /logout
+func (UnimplementedHandler) LogoutPost(ctx context.Context, req OptLogoutPostReq, params LogoutPostParams) (r LogoutPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductTypesGet implements GET /product-types operation.
+//
+// Список типов товаров (только для модераторов).
+//
+// GET /product-types
+func (UnimplementedHandler) ProductTypesGet(ctx context.Context) (r ProductTypesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductTypesNameDelete implements DELETE /product-types/{name} operation.
+//
+// Удаление типа товара из справочника (только для
+// модераторов).
+//
+// DELETE /product-types/{name}
+func (UnimplementedHandler) ProductTypesNameDelete(ctx context.Context, params ProductTypesNameDeleteParams) (r ProductTypesNameDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductTypesNamePut implements PUT /product-types/{name} operation.
+//
+// Активация или деактивация типа товара (только для
+// модераторов).
+//
+// PUT /product-types/{name}
+func (UnimplementedHandler) ProductTypesNamePut(ctx context.Context, req *DictionaryEntryUpdate, params ProductTypesNamePutParams) (r ProductTypesNamePutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductTypesPost implements POST /product-types operation.
+//
+// Добавление типа товара в справочник (только для
+// модераторов).
+//
+// POST /product-types
+func (UnimplementedHandler) ProductTypesPost(ctx context.Context, req *DictionaryEntry, params ProductTypesPostParams) (r ProductTypesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductsBatchPost implements POST /products/batch operation.
+//
+// Все товары добавляются в одной транзакции. Товары, не
+// прошедшие проверку, не добавляются и не мешают
+// добавлению остальных.
+//
+// POST /products/batch
+func (UnimplementedHandler) ProductsBatchPost(ctx context.Context, req *ProductsBatchPostReq, params ProductsBatchPostParams) (r ProductsBatchPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProductsGet implements GET /products operation.
+//
+// Поиск товаров по штрихкоду во всех приемках.
+//
+// GET /products
+func (UnimplementedHandler) ProductsGet(ctx context.Context, params ProductsGetParams) (r ProductsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ProductsPost implements POST /products operation.
 //
 // Добавление товара в текущую приемку (только для
 // сотрудников ПВЗ).
 //
 // POST /products
-func (UnimplementedHandler) ProductsPost(ctx context.Context, req *ProductsPostReq) (r ProductsPostRes, _ error) {
+func (UnimplementedHandler) ProductsPost(ctx context.Context, req *ProductsPostReq, params ProductsPostParams) (r ProductsPostRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -47,7 +164,17 @@ func (UnimplementedHandler) ProductsPost(ctx context.Context, req *ProductsPostR
 // пагинацией.
 //
 // GET /pvz
-func (UnimplementedHandler) PvzGet(ctx context.Context, params PvzGetParams) (r []PvzGetOKItem, _ error) {
+func (UnimplementedHandler) PvzGet(ctx context.Context, params PvzGetParams) (r PvzGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PvzNearbyGet implements GET /pvz/nearby operation.
+//
+// Действующие ПВЗ с координатами в пределах радиуса,
+// отсортированные по расстоянию.
+//
+// GET /pvz/nearby
+func (UnimplementedHandler) PvzNearbyGet(ctx context.Context, params PvzNearbyGetParams) (r PvzNearbyGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -56,7 +183,7 @@ func (UnimplementedHandler) PvzGet(ctx context.Context, params PvzGetParams) (r
 // Создание ПВЗ (только для модераторов).
 //
 // POST /pvz
-func (UnimplementedHandler) PvzPost(ctx context.Context, req *PVZ) (r PvzPostRes, _ error) {
+func (UnimplementedHandler) PvzPost(ctx context.Context, req *PVZ, params PvzPostParams) (r PvzPostRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -70,6 +197,16 @@ func (UnimplementedHandler) PvzPvzIdCloseLastReceptionPost(ctx context.Context,
 	return r, ht.ErrNotImplemented
 }
 
+// PvzPvzIdDecommissionPost implements POST /pvz/{pvzId}/decommission operation.
+//
+// ПВЗ переводится в статус closed, новые приемки в нем
+// открывать нельзя.
+//
+// POST /pvz/{pvzId}/decommission
+func (UnimplementedHandler) PvzPvzIdDecommissionPost(ctx context.Context, params PvzPvzIdDecommissionPostParams) (r PvzPvzIdDecommissionPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PvzPvzIdDeleteLastProductPost implements POST /pvz/{pvzId}/delete_last_product operation.
 //
 // Удаление последнего добавленного товара из текущей
@@ -80,13 +217,111 @@ func (UnimplementedHandler) PvzPvzIdDeleteLastProductPost(ctx context.Context, p
 	return r, ht.ErrNotImplemented
 }
 
+// PvzPvzIdGet implements GET /pvz/{pvzId} operation.
+//
+// Получение ПВЗ.
+//
+// GET /pvz/{pvzId}
+func (UnimplementedHandler) PvzPvzIdGet(ctx context.Context, params PvzPvzIdGetParams) (r PvzPvzIdGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PvzPvzIdPatch implements PATCH /pvz/{pvzId} operation.
+//
+// Изменение ПВЗ (только для модераторов).
+//
+// PATCH /pvz/{pvzId}
+func (UnimplementedHandler) PvzPvzIdPatch(ctx context.Context, req *PVZUpdate, params PvzPvzIdPatchParams) (r PvzPvzIdPatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PvzPvzIdUndoLastDeletePost implements POST /pvz/{pvzId}/undo_last_delete operation.
+//
+// Восстановление последнего удаленного товара в
+// текущей приемке (только для сотрудников ПВЗ).
+//
+// POST /pvz/{pvzId}/undo_last_delete
+func (UnimplementedHandler) PvzPvzIdUndoLastDeletePost(ctx context.Context, params PvzPvzIdUndoLastDeletePostParams) (r PvzPvzIdUndoLastDeletePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReceptionsPost implements POST /receptions operation.
 //
 // Создание новой приемки товаров (только для
 // сотрудников ПВЗ).
 //
 // POST /receptions
-func (UnimplementedHandler) ReceptionsPost(ctx context.Context, req *ReceptionsPostReq) (r ReceptionsPostRes, _ error) {
+func (UnimplementedHandler) ReceptionsPost(ctx context.Context, req *ReceptionsPostReq, params ReceptionsPostParams) (r ReceptionsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdDiscrepanciesGet implements GET /receptions/{receptionId}/discrepancies operation.
+//
+// Сверка принятых товаров с манифестом приемки.
+//
+// GET /receptions/{receptionId}/discrepancies
+func (UnimplementedHandler) ReceptionsReceptionIdDiscrepanciesGet(ctx context.Context, params ReceptionsReceptionIdDiscrepanciesGetParams) (r ReceptionsReceptionIdDiscrepanciesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdManifestGet implements GET /receptions/{receptionId}/manifest operation.
+//
+// Манифест приемки.
+//
+// GET /receptions/{receptionId}/manifest
+func (UnimplementedHandler) ReceptionsReceptionIdManifestGet(ctx context.Context, params ReceptionsReceptionIdManifestGetParams) (r ReceptionsReceptionIdManifestGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdManifestPut implements PUT /receptions/{receptionId}/manifest operation.
+//
+// Заменяет манифест приемки целиком. Позиции с
+// одинаковым типом или штрихкодом суммируются.
+// В формате CSV первая строка - заголовок с колонками type,
+// barcode, quantity.
+//
+// PUT /receptions/{receptionId}/manifest
+func (UnimplementedHandler) ReceptionsReceptionIdManifestPut(ctx context.Context, req ReceptionsReceptionIdManifestPutReq, params ReceptionsReceptionIdManifestPutParams) (r ReceptionsReceptionIdManifestPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdProductsProductIdDelete implements DELETE /receptions/{receptionId}/products/{productId} operation.
+//
+// Удаление произвольного товара из открытой приемки
+// (только для сотрудников ПВЗ).
+//
+// DELETE /receptions/{receptionId}/products/{productId}
+func (UnimplementedHandler) ReceptionsReceptionIdProductsProductIdDelete(ctx context.Context, params ReceptionsReceptionIdProductsProductIdDeleteParams) (r ReceptionsReceptionIdProductsProductIdDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdReopenPost implements POST /receptions/{receptionId}/reopen operation.
+//
+// Приемку можно открыть повторно, только если она
+// последняя в ПВЗ и была закрыта не раньше, чем заданное
+// в конфигурации окно. Причина сохраняется в истории
+// приемки.
+//
+// POST /receptions/{receptionId}/reopen
+func (UnimplementedHandler) ReceptionsReceptionIdReopenPost(ctx context.Context, req *ReceptionsReceptionIdReopenPostReq, params ReceptionsReceptionIdReopenPostParams) (r ReceptionsReceptionIdReopenPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReceptionsReceptionIdSummaryGet implements GET /receptions/{receptionId}/summary operation.
+//
+// Сводка по приемке товаров.
+//
+// GET /receptions/{receptionId}/summary
+func (UnimplementedHandler) ReceptionsReceptionIdSummaryGet(ctx context.Context, params ReceptionsReceptionIdSummaryGetParams) (r ReceptionsReceptionIdSummaryGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshPost implements POST /refresh operation.
+//
+// Обновление токена доступа по refresh-токену.
+//
+// POST /refresh
+func (UnimplementedHandler) RefreshPost(ctx context.Context, req *RefreshPostReq) (r RefreshPostRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -95,6 +330,45 @@ func (UnimplementedHandler) ReceptionsPost(ctx context.Context, req *ReceptionsP
 // Регистрация пользователя.
 //
 // POST /register
-func (UnimplementedHandler) RegisterPost(ctx context.Context, req *RegisterPostReq) (r RegisterPostRes, _ error) {
+func (UnimplementedHandler) RegisterPost(ctx context.Context, req *RegisterPostReq, params RegisterPostParams) (r RegisterPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UsersUserIdPvzGet implements GET /users/{userId}/pvz operation.
+//
+// Список ПВЗ, закрепленных за сотрудником (только для
+// модераторов).
+//
+// GET /users/{userId}/pvz
+func (UnimplementedHandler) UsersUserIdPvzGet(ctx context.Context, params UsersUserIdPvzGetParams) (r UsersUserIdPvzGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UsersUserIdPvzPvzIdDelete implements DELETE /users/{userId}/pvz/{pvzId} operation.
+//
+// Открепление сотрудника от ПВЗ (только для
+// модераторов).
+//
+// DELETE /users/{userId}/pvz/{pvzId}
+func (UnimplementedHandler) UsersUserIdPvzPvzIdDelete(ctx context.Context, params UsersUserIdPvzPvzIdDeleteParams) (r UsersUserIdPvzPvzIdDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UsersUserIdPvzPvzIdPut implements PUT /users/{userId}/pvz/{pvzId} operation.
+//
+// Закрепление сотрудника за ПВЗ (только для
+// модераторов).
+//
+// PUT /users/{userId}/pvz/{pvzId}
+func (UnimplementedHandler) UsersUserIdPvzPvzIdPut(ctx context.Context, params UsersUserIdPvzPvzIdPutParams) (r UsersUserIdPvzPvzIdPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WellKnownJwksJSONGet implements GET /.well-known/jwks.json operation.
+//
+// Публичные ключи для проверки подписи токенов.
+//
+// GET /.well-known/jwks.json
+func (UnimplementedHandler) WellKnownJwksJSONGet(ctx context.Context) (r *WellKnownJwksJSONGetOK, _ error) {
 	return r, ht.ErrNotImplemented
 }
@@ -0,0 +1,5 @@
+package grpc
+
+import "errors"
+
+var ErrFailedToParseUUID = errors.New("failed to parse uuid")
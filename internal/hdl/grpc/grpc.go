@@ -5,17 +5,26 @@ import (
 	"errors"
 	"fmt"
 	gen "github.com/JMURv/avito-spring/api/grpc/v1/gen"
+	"github.com/JMURv/avito-spring/internal/auth"
 	"github.com/JMURv/avito-spring/internal/ctrl"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	"github.com/JMURv/avito-spring/internal/hdl"
+	"github.com/JMURv/avito-spring/internal/hdl/grpc/interceptors"
+	md "github.com/JMURv/avito-spring/internal/models"
 	"github.com/JMURv/avito-spring/internal/models/mapper"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
+	"io"
 	"net"
+	"time"
 )
 
 type Handler struct {
@@ -25,8 +34,59 @@ type Handler struct {
 	ctrl ctrl.AppCtrl
 }
 
-func New(name string, ctrl ctrl.AppCtrl) *Handler {
-	srv := grpc.NewServer()
+// methodRoles mirrors the role checks of the HTTP routes. Methods missing here and from publicMethods are rejected.
+var methodRoles = map[string][]string{
+	gen.PVZService_GetPVZList_FullMethodName:          {md.ModeratorRole, md.EmployeeRole},
+	gen.PVZService_GetPVZ_FullMethodName:              {md.ModeratorRole, md.EmployeeRole},
+	gen.PVZService_FindNearbyPVZ_FullMethodName:       {md.ModeratorRole, md.EmployeeRole},
+	gen.PVZService_CreatePVZ_FullMethodName:           {md.ModeratorRole},
+	gen.PVZService_CloseLastReception_FullMethodName:  {},
+	gen.PVZService_DeleteLastProduct_FullMethodName:   {md.EmployeeRole},
+	gen.PVZService_UndoLastDelete_FullMethodName:      {md.EmployeeRole},
+	gen.PVZService_CreateReception_FullMethodName:     {md.EmployeeRole},
+	gen.PVZService_AddItemToReception_FullMethodName:  {md.EmployeeRole},
+	gen.PVZService_AddItemsToReception_FullMethodName: {md.EmployeeRole},
+	gen.PVZService_WatchPVZ_FullMethodName:            {md.ModeratorRole, md.EmployeeRole},
+}
+
+// publicMethods are served without authentication, so that probes and tooling work without a token.
+var publicMethods = []string{
+	grpc_health_v1.Health_Check_FullMethodName,
+	grpc_health_v1.Health_Watch_FullMethodName,
+	grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName,
+	grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName,
+}
+
+// idempotentMethods accept the idempotency-key metadata, they mirror the POST routes of the HTTP API.
+// Every unary method that changes state must be listed here, the read-only ones are left out.
+var idempotentMethods = []string{
+	gen.PVZService_CreatePVZ_FullMethodName,
+	gen.PVZService_CloseLastReception_FullMethodName,
+	gen.PVZService_DeleteLastProduct_FullMethodName,
+	gen.PVZService_UndoLastDelete_FullMethodName,
+	gen.PVZService_CreateReception_FullMethodName,
+	gen.PVZService_AddItemToReception_FullMethodName,
+}
+
+// nonIdempotentStreams reject the idempotency-key metadata instead of silently ignoring it. Unlike
+// POST /products/batch, AddItemsToReception cannot be replayed: the request is only known once the
+// stream ends, after the products are added. Clients that retry use AddItemToReception per item or the
+// HTTP batch route.
+var nonIdempotentStreams = []string{
+	gen.PVZService_AddItemsToReception_FullMethodName,
+}
+
+func New(name string, ctrl ctrl.AppCtrl, au auth.Core) *Handler {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.AuthUnary(au, methodRoles, publicMethods),
+			interceptors.Idempotency(ctrl, idempotentMethods),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.AuthStream(au, methodRoles, publicMethods),
+			interceptors.RejectIdempotencyKey(nonIdempotentStreams),
+		),
+	)
 	reflection.Register(srv)
 
 	hsrv := health.NewServer()
@@ -67,12 +127,361 @@ func (h *Handler) GetPVZList(ctx context.Context, req *gen.GetPVZListRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
 	}
 
-	res, err := h.ctrl.GetPVZList(ctx)
+	res, next, err := h.ctrl.GetPVZList(ctx, req.Cursor, req.Limit)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrInvalidCursor) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
 	}
 
 	return &gen.GetPVZListResponse{
-		Pvzs: mapper.ListPVZsToProto(res),
+		Pvzs:       mapper.ListPVZsToProto(res),
+		NextCursor: next,
+	}, nil
+}
+
+func (h *Handler) GetPVZ(ctx context.Context, req *gen.GetPVZRequest) (*gen.GetPVZResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	startDate := time.Now().AddDate(-1000, 0, 0)
+	if req.StartDate != nil {
+		startDate = req.StartDate.AsTime()
+	}
+
+	endDate := time.Now()
+	if req.EndDate != nil {
+		endDate = req.EndDate.AsTime()
+	}
+
+	res, next, err := h.ctrl.GetPVZ(ctx, page, limit, req.Cursor, startDate, endDate, req.IncludeDeleted)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrInvalidCursor) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.GetPVZResponse{
+		Items:      mapper.ListPVZWithReceptionsToProto(res),
+		NextCursor: next,
+	}, nil
+}
+
+func (h *Handler) FindNearbyPVZ(ctx context.Context, req *gen.FindNearbyPVZRequest) (*gen.FindNearbyPVZResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	res, err := h.ctrl.FindNearbyPVZ(ctx, req.Latitude, req.Longitude, req.Radius, req.OpenNow, req.Limit)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCoordinatesIsNotValid) || errors.Is(err, ctrl.ErrRadiusIsNotValid) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.FindNearbyPVZResponse{
+		Items: mapper.ListNearbyPVZToProto(res),
+	}, nil
+}
+
+func (h *Handler) CreatePVZ(ctx context.Context, req *gen.CreatePVZRequest) (*gen.CreatePVZResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvz := mapper.CreatePVZRequestToDTO(req)
+	if err := pvz.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.CreatePVZ(ctx, pvz)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCityIsNotValid) ||
+			errors.Is(err, ctrl.ErrWorkingHoursIsNotValid) ||
+			errors.Is(err, ctrl.ErrTimezoneIsNotValid) ||
+			errors.Is(err, ctrl.ErrTimezoneRequired) ||
+			errors.Is(err, ctrl.ErrCoordinatesIsNotValid) ||
+			errors.Is(err, ctrl.ErrCapacityIsNotValid) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.CreatePVZResponse{
+		Pvz: mapper.PVZDTOToProto(res),
+	}, nil
+}
+
+func (h *Handler) CreateReception(ctx context.Context, req *gen.CreateReceptionRequest) (*gen.CreateReceptionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvzID, err := uuid.Parse(req.PvzId)
+	if err != nil || pvzID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+	}
+
+	res, err := h.ctrl.CreateReception(ctx, &dto.ReceptionsPostReq{PvzId: pvzID})
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrReceptionStillOpen) || errors.Is(err, ctrl.ErrPVZClosed) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrPVZNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.CreateReceptionResponse{
+		Reception: mapper.ReceptionToProto(res),
+	}, nil
+}
+
+func (h *Handler) AddItemToReception(ctx context.Context, req *gen.AddItemToReceptionRequest) (*gen.AddItemToReceptionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvzID, err := uuid.Parse(req.PvzId)
+	if err != nil || pvzID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+	}
+
+	item := &dto.ProductsPostReq{
+		Type:  req.Type,
+		PvzId: pvzID,
+	}
+	if req.Barcode != "" {
+		item.Barcode = dto.NewOptString(req.Barcode)
+	}
+	if req.Sku != "" {
+		item.Sku = dto.NewOptString(req.Sku)
+	}
+	if req.Quantity != 0 {
+		item.Quantity = dto.NewOptInt(int(req.Quantity))
+	}
+	if req.Weight != 0 {
+		item.Weight = dto.NewOptFloat64(req.Weight)
+	}
+	if err = item.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := h.ctrl.AddItemToReception(ctx, item)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrTypeIsNotValid) || errors.Is(err, ctrl.ErrBarcodeIsNotValid) ||
+			errors.Is(err, ctrl.ErrQuantityIsNotValid) || errors.Is(err, ctrl.ErrWeightIsNotValid) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrBarcodeAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.AddItemToReceptionResponse{
+		Product: mapper.ProductToProto(res),
+	}, nil
+}
+
+// AddItemsToReception collects the products streamed by a device and adds them in a single batch once
+// the client closes the stream. Every message must refer to the same PVZ.
+func (h *Handler) AddItemsToReception(stream gen.PVZService_AddItemsToReceptionServer) error {
+	req := &dto.ProductsBatchPostReq{}
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			zap.L().Debug("Failed to receive product", zap.Error(err))
+			return err
+		}
+
+		pvzID, err := uuid.Parse(msg.PvzId)
+		if err != nil || pvzID == uuid.Nil || (req.PvzId != uuid.Nil && req.PvzId != pvzID) {
+			return status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+		}
+
+		if len(req.Items) == ctrl.MaxBatchSize {
+			return status.Errorf(codes.InvalidArgument, ctrl.ErrInvalidBatchSize.Error())
+		}
+
+		item := dto.ProductBatchItem{Type: msg.Type}
+		if msg.ScannedAt != nil {
+			item.ScannedAt = dto.NewOptDateTime(msg.ScannedAt.AsTime())
+		}
+		if msg.Barcode != "" {
+			item.Barcode = dto.NewOptString(msg.Barcode)
+		}
+		if msg.Sku != "" {
+			item.Sku = dto.NewOptString(msg.Sku)
+		}
+		if msg.Quantity != 0 {
+			item.Quantity = dto.NewOptInt(int(msg.Quantity))
+		}
+		if msg.Weight != 0 {
+			item.Weight = dto.NewOptFloat64(msg.Weight)
+		}
+
+		req.PvzId = pvzID
+		req.Items = append(req.Items, item)
+	}
+
+	res, err := h.ctrl.AddItemsToReception(stream.Context(), req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrInvalidBatchSize) {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) {
+			return status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		return status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return stream.SendAndClose(mapper.ProductBatchToProto(res))
+}
+
+func (h *Handler) DeleteLastProduct(ctx context.Context, req *gen.DeleteLastProductRequest) (*gen.DeleteLastProductResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvzID, err := uuid.Parse(req.PvzId)
+	if err != nil || pvzID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+	}
+
+	err = h.ctrl.DeleteLastProduct(ctx, pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrNoItems) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.DeleteLastProductResponse{}, nil
+}
+
+func (h *Handler) UndoLastDelete(ctx context.Context, req *gen.UndoLastDeleteRequest) (*gen.UndoLastDeleteResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvzID, err := uuid.Parse(req.PvzId)
+	if err != nil || pvzID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+	}
+
+	res, err := h.ctrl.UndoLastDelete(ctx, pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrNoDeletedItems) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrBarcodeAlreadyExists) {
+			return nil, status.Errorf(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	return &gen.UndoLastDeleteResponse{
+		Product: mapper.ProductToProto(res),
 	}, nil
 }
+
+func (h *Handler) CloseLastReception(ctx context.Context, req *gen.CloseLastReceptionRequest) (*gen.CloseLastReceptionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	pvzID, err := uuid.Parse(req.PvzId)
+	if err != nil || pvzID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+	}
+
+	res, err := h.ctrl.CloseLastReception(ctx, pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, ctrl.ErrReceptionAlreadyClosed) || errors.Is(err, ctrl.ErrManifestDiscrepancies) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	resp := &gen.CloseLastReceptionResponse{
+		Reception: mapper.ReceptionToProto(res),
+	}
+	if res.Discrepancies.Set {
+		resp.Discrepancies = mapper.DiscrepancyReportToProto(&res.Discrepancies.Value)
+	}
+	return resp, nil
+}
+
+func (h *Handler) WatchPVZ(req *gen.WatchPVZRequest, stream gen.PVZService_WatchPVZServer) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+	}
+
+	var pvzID uuid.UUID
+	if req.PvzId != "" {
+		var err error
+		pvzID, err = uuid.Parse(req.PvzId)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, ErrFailedToParseUUID.Error())
+		}
+	}
+
+	ctx := stream.Context()
+	events, unsubscribe := h.ctrl.WatchPVZ(ctx, pvzID, req.City)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(mapper.EventToProto(e)); err != nil {
+				zap.L().Debug("Failed to send event", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
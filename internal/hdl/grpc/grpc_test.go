@@ -4,25 +4,67 @@ import (
 	"context"
 	"errors"
 	gen "github.com/JMURv/avito-spring/api/grpc/v1/gen"
+	"github.com/JMURv/avito-spring/internal/ctrl"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	"github.com/JMURv/avito-spring/internal/hdl"
 	md "github.com/JMURv/avito-spring/internal/models"
 	"github.com/JMURv/avito-spring/tests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"slices"
 	"testing"
 	"time"
 )
 
+func TestMethodRoles(t *testing.T) {
+	// Methods missing from the role table are rejected, so every method of the service must be listed.
+	for _, m := range gen.PVZService_ServiceDesc.Methods {
+		assert.Contains(t, methodRoles, "/"+gen.PVZService_ServiceDesc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range gen.PVZService_ServiceDesc.Streams {
+		assert.Contains(t, methodRoles, "/"+gen.PVZService_ServiceDesc.ServiceName+"/"+s.StreamName)
+	}
+	assert.ElementsMatch(t, []string{md.ModeratorRole, md.EmployeeRole}, methodRoles[gen.PVZService_GetPVZList_FullMethodName])
+}
+
+func TestIdempotentMethods(t *testing.T) {
+	// Methods that are not idempotent must state why.
+	excluded := map[string]string{
+		gen.PVZService_GetPVZList_FullMethodName:          "read-only",
+		gen.PVZService_GetPVZ_FullMethodName:              "read-only",
+		gen.PVZService_FindNearbyPVZ_FullMethodName:       "read-only",
+		gen.PVZService_WatchPVZ_FullMethodName:            "read-only stream",
+		gen.PVZService_AddItemsToReception_FullMethodName: "client stream, rejects the key",
+	}
+
+	names := make([]string, 0)
+	for _, m := range gen.PVZService_ServiceDesc.Methods {
+		names = append(names, "/"+gen.PVZService_ServiceDesc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range gen.PVZService_ServiceDesc.Streams {
+		names = append(names, "/"+gen.PVZService_ServiceDesc.ServiceName+"/"+s.StreamName)
+	}
+
+	for _, name := range names {
+		_, skip := excluded[name]
+		assert.NotEqual(t, skip, slices.Contains(idempotentMethods, name), name)
+	}
+	assert.Contains(t, nonIdempotentStreams, gen.PVZService_AddItemsToReception_FullMethodName)
+}
+
 func TestHandler_GetPVZList(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
 	testErr := errors.New("test error")
 	mctrl := mocks.NewMockAppCtrl(mock)
-	h := New("test-svc", mctrl)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
 
 	tests := []struct {
 		name       string
@@ -47,8 +89,8 @@ func TestHandler_GetPVZList(t *testing.T) {
 			req:  &gen.GetPVZListRequest{},
 			expect: func() {
 				mctrl.EXPECT().
-					GetPVZList(gomock.Any()).
-					Return(nil, testErr)
+					GetPVZList(gomock.Any(), "", int64(0)).
+					Return(nil, "", testErr)
 			},
 			assertions: func(res *gen.GetPVZListResponse, err error) {
 				assert.Nil(t, res)
@@ -60,10 +102,10 @@ func TestHandler_GetPVZList(t *testing.T) {
 		},
 		{
 			name: "Success",
-			req:  &gen.GetPVZListRequest{},
+			req:  &gen.GetPVZListRequest{Cursor: "cursor", Limit: 1},
 			expect: func() {
 				mctrl.EXPECT().
-					GetPVZList(gomock.Any()).
+					GetPVZList(gomock.Any(), "cursor", int64(1)).
 					Return(
 						[]*md.PVZ{
 							{
@@ -71,7 +113,7 @@ func TestHandler_GetPVZList(t *testing.T) {
 								City:             "TestCity",
 								RegistrationDate: time.Now(),
 							},
-						}, nil,
+						}, "next", nil,
 					)
 			},
 			assertions: func(res *gen.GetPVZListResponse, err error) {
@@ -79,6 +121,7 @@ func TestHandler_GetPVZList(t *testing.T) {
 				assert.NotNil(t, res)
 				assert.Len(t, res.Pvzs, 1)
 				assert.Equal(t, "TestCity", res.Pvzs[0].City)
+				assert.Equal(t, "next", res.NextCursor)
 			},
 		},
 	}
@@ -93,3 +136,1228 @@ func TestHandler_GetPVZList(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_GetPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+	recID := uuid.New()
+	start := time.Now().Add(-time.Hour)
+	end := time.Now()
+
+	tests := []struct {
+		name       string
+		req        *gen.GetPVZRequest
+		expect     func()
+		assertions func(*gen.GetPVZResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.GetPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, hdl.ErrDecodeRequest.Error(), st.Message())
+			},
+		},
+		{
+			name: "InvalidCursor",
+			req:  &gen.GetPVZRequest{Cursor: "bad"},
+			expect: func() {
+				mctrl.EXPECT().
+					GetPVZ(gomock.Any(), int64(1), int64(10), "bad", gomock.Any(), gomock.Any(), false).
+					Return(nil, "", ctrl.ErrInvalidCursor)
+			},
+			assertions: func(res *gen.GetPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ctrl.ErrInvalidCursor.Error(), st.Message())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.GetPVZRequest{},
+			expect: func() {
+				mctrl.EXPECT().
+					GetPVZ(gomock.Any(), int64(1), int64(10), "", gomock.Any(), gomock.Any(), false).
+					Return(nil, "", testErr)
+			},
+			assertions: func(res *gen.GetPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+				assert.Equal(t, hdl.ErrInternal.Error(), st.Message())
+			},
+		},
+		{
+			name: "Success",
+			req: &gen.GetPVZRequest{
+				StartDate: timestamppb.New(start),
+				EndDate:   timestamppb.New(end),
+				Page:      2,
+				Limit:     5,
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					GetPVZ(gomock.Any(), int64(2), int64(5), "", start.UTC(), end.UTC(), false).
+					Return(
+						[]*dto.PvzGetOKItem{
+							{
+								Pvz: dto.OptPVZ{
+									Set: true,
+									Value: dto.PVZ{
+										ID:   dto.OptUUID{Set: true, Value: pvzID},
+										City: "Москва",
+									},
+								},
+								Receptions: []dto.PvzGetOKItemReceptionsItem{
+									{
+										Reception: dto.OptReception{
+											Set: true,
+											Value: dto.Reception{
+												ID:       dto.OptUUID{Set: true, Value: recID},
+												DateTime: time.Now(),
+												PvzId:    pvzID,
+												Status:   dto.ReceptionStatusInProgress,
+											},
+										},
+										Products: []dto.Product{
+											{
+												Type:        "электроника",
+												ReceptionId: recID,
+											},
+										},
+									},
+								},
+							},
+						}, "", nil,
+					)
+			},
+			assertions: func(res *gen.GetPVZResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Items, 1)
+				assert.Equal(t, pvzID.String(), res.Items[0].Pvz.Id)
+				assert.Len(t, res.Items[0].Receptions, 1)
+				assert.Equal(t, recID.String(), res.Items[0].Receptions[0].Reception.Id)
+				assert.Equal(
+					t,
+					gen.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+					res.Items[0].Receptions[0].Reception.Status,
+				)
+				assert.Len(t, res.Items[0].Receptions[0].Products, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.GetPVZ(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_FindNearbyPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	req := &gen.FindNearbyPVZRequest{Latitude: 55.7558, Longitude: 37.6173, Radius: 3000, OpenNow: true, Limit: 5}
+	tests := []struct {
+		name       string
+		req        *gen.FindNearbyPVZRequest
+		expect     func()
+		assertions func(*gen.FindNearbyPVZResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.FindNearbyPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ErrRadiusIsNotValid",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 3000.0, true, int64(5)).
+					Return(nil, ctrl.ErrRadiusIsNotValid)
+			},
+			assertions: func(res *gen.FindNearbyPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ctrl.ErrRadiusIsNotValid.Error(), st.Message())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 3000.0, true, int64(5)).
+					Return(nil, errors.New("test error"))
+			},
+			assertions: func(res *gen.FindNearbyPVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  req,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 3000.0, true, int64(5)).
+					Return(
+						[]*dto.NearbyPVZ{
+							{
+								Pvz: dto.PVZ{
+									ID:          dto.NewOptUUID(uuid.New()),
+									City:        "Москва",
+									Coordinates: dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7658, Longitude: 37.6049}),
+								},
+								Distance: 1312.4,
+							},
+						}, nil,
+					)
+			},
+			assertions: func(res *gen.FindNearbyPVZResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Items, 1)
+				assert.Equal(t, 1312.4, res.Items[0].Distance)
+				assert.Equal(t, 55.7658, res.Items[0].Pvz.Coordinates.Latitude)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.FindNearbyPVZ(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_CreatePVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	tests := []struct {
+		name       string
+		req        *gen.CreatePVZRequest
+		expect     func()
+		assertions func(*gen.CreatePVZResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:   "EmptyCity",
+			req:    &gen.CreatePVZRequest{},
+			expect: func() {},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidCity",
+			req:  &gen.CreatePVZRequest{City: "Новосибирск"},
+			expect: func() {
+				mctrl.EXPECT().
+					CreatePVZ(gomock.Any(), &dto.PVZ{City: "Новосибирск"}).
+					Return(nil, ctrl.ErrCityIsNotValid)
+			},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ctrl.ErrCityIsNotValid.Error(), st.Message())
+			},
+		},
+		{
+			name: "InvalidDay",
+			req: &gen.CreatePVZRequest{
+				City:         "Москва",
+				WorkingHours: []*gen.WorkingDay{{Day: "holiday", Open: "09:00", Close: "21:00"}},
+			},
+			expect: func() {},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidTimezone",
+			req:  &gen.CreatePVZRequest{City: "Москва", Timezone: "Mars/Olympus"},
+			expect: func() {
+				mctrl.EXPECT().
+					CreatePVZ(gomock.Any(), &dto.PVZ{City: "Москва", Timezone: dto.NewOptString("Mars/Olympus")}).
+					Return(nil, ctrl.ErrTimezoneIsNotValid)
+			},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ctrl.ErrTimezoneIsNotValid.Error(), st.Message())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.CreatePVZRequest{City: "Москва"},
+			expect: func() {
+				mctrl.EXPECT().
+					CreatePVZ(gomock.Any(), &dto.PVZ{City: "Москва"}).
+					Return(nil, testErr)
+			},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+				assert.Equal(t, hdl.ErrInternal.Error(), st.Message())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.CreatePVZRequest{City: "Москва"},
+			expect: func() {
+				mctrl.EXPECT().
+					CreatePVZ(gomock.Any(), &dto.PVZ{City: "Москва"}).
+					Return(
+						&dto.PVZ{
+							ID:               dto.OptUUID{Set: true, Value: uuid.New()},
+							RegistrationDate: dto.OptDateTime{Set: true, Value: time.Now()},
+							City:             "Москва",
+						}, nil,
+					)
+			},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Москва", res.Pvz.City)
+				assert.NotEmpty(t, res.Pvz.Id)
+				assert.NotNil(t, res.Pvz.RegistrationDate)
+			},
+		},
+		{
+			name: "SuccessWithLocation",
+			req: &gen.CreatePVZRequest{
+				City:         "Москва",
+				Address:      "ул. Тверская, 1",
+				WorkingHours: []*gen.WorkingDay{{Day: "mon", Open: "09:00", Close: "21:00"}},
+				Timezone:     "Europe/Moscow",
+				Coordinates:  &gen.Coordinates{Latitude: 55.7658, Longitude: 37.6049},
+				Capacity:     500,
+			},
+			expect: func() {
+				req := &dto.PVZ{
+					City:         "Москва",
+					Address:      dto.NewOptString("ул. Тверская, 1"),
+					WorkingHours: dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"}},
+					Timezone:     dto.NewOptString("Europe/Moscow"),
+					Coordinates:  dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7658, Longitude: 37.6049}),
+					Capacity:     dto.NewOptInt(500),
+				}
+				res := *req
+				res.ID = dto.NewOptUUID(uuid.New())
+				mctrl.EXPECT().CreatePVZ(gomock.Any(), req).Return(&res, nil)
+			},
+			assertions: func(res *gen.CreatePVZResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Europe/Moscow", res.Pvz.Timezone)
+				assert.Equal(t, 37.6049, res.Pvz.Coordinates.Longitude)
+				assert.Equal(t, int64(500), res.Pvz.Capacity)
+				assert.Len(t, res.Pvz.WorkingHours, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.CreatePVZ(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateReception(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		req        *gen.CreateReceptionRequest
+		expect     func()
+		assertions func(*gen.CreateReceptionResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:   "InvalidUUID",
+			req:    &gen.CreateReceptionRequest{PvzId: "wrong"},
+			expect: func() {},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ErrFailedToParseUUID.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrReceptionStillOpen",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(nil, ctrl.ErrReceptionStillOpen)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrReceptionStillOpen.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrPVZClosed",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(nil, ctrl.ErrPVZClosed)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrPVZClosed.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrPVZNotFound",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(nil, ctrl.ErrPVZNotFound)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "ErrPVZForbidden",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(nil, ctrl.ErrPVZForbidden)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(nil, testErr)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.CreateReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateReception(gomock.Any(), &dto.ReceptionsPostReq{PvzId: pvzID}).
+					Return(
+						&dto.Reception{
+							ID:       dto.OptUUID{Set: true, Value: uuid.New()},
+							DateTime: time.Now(),
+							PvzId:    pvzID,
+							Status:   dto.ReceptionStatusInProgress,
+						}, nil,
+					)
+			},
+			assertions: func(res *gen.CreateReceptionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, pvzID.String(), res.Reception.PvzId)
+				assert.Equal(t, gen.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, res.Reception.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.CreateReception(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_AddItemToReception(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+	item := &dto.ProductsPostReq{
+		Type:  "электроника",
+		PvzId: pvzID,
+	}
+
+	tests := []struct {
+		name       string
+		req        *gen.AddItemToReceptionRequest
+		expect     func()
+		assertions func(*gen.AddItemToReceptionResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:   "InvalidUUID",
+			req:    &gen.AddItemToReceptionRequest{PvzId: "wrong", Type: "электроника"},
+			expect: func() {},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ErrFailedToParseUUID.Error(), st.Message())
+			},
+		},
+		{
+			name: "InvalidType",
+			req:  &gen.AddItemToReceptionRequest{PvzId: pvzID.String(), Type: "мебель"},
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemToReception(gomock.Any(), &dto.ProductsPostReq{Type: "мебель", PvzId: pvzID}).
+					Return(nil, ctrl.ErrTypeIsNotValid)
+			},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Equal(t, ctrl.ErrTypeIsNotValid.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrNoActiveReception",
+			req:  &gen.AddItemToReceptionRequest{PvzId: pvzID.String(), Type: "электроника"},
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemToReception(gomock.Any(), item).
+					Return(nil, ctrl.ErrNoActiveReception)
+			},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrNoActiveReception.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrBarcodeAlreadyExists",
+			req: &gen.AddItemToReceptionRequest{
+				PvzId:    pvzID.String(),
+				Type:     "электроника",
+				Barcode:  "4006381333931",
+				Quantity: 2,
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemToReception(
+						gomock.Any(), &dto.ProductsPostReq{
+							PvzId:    pvzID,
+							Type:     "электроника",
+							Barcode:  dto.NewOptString("4006381333931"),
+							Quantity: dto.NewOptInt(2),
+						},
+					).
+					Return(nil, ctrl.ErrBarcodeAlreadyExists)
+			},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.AddItemToReceptionRequest{PvzId: pvzID.String(), Type: "электроника"},
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemToReception(gomock.Any(), item).
+					Return(nil, testErr)
+			},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.AddItemToReceptionRequest{PvzId: pvzID.String(), Type: "электроника"},
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemToReception(gomock.Any(), item).
+					Return(
+						&dto.Product{
+							ID:          dto.OptUUID{Set: true, Value: uuid.New()},
+							DateTime:    dto.OptDateTime{Set: true, Value: time.Now()},
+							Type:        "электроника",
+							ReceptionId: uuid.New(),
+						}, nil,
+					)
+			},
+			assertions: func(res *gen.AddItemToReceptionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "электроника", res.Product.Type)
+				assert.NotEmpty(t, res.Product.Id)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.AddItemToReception(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+type batchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*gen.AddItemsToReceptionRequest
+	res  *gen.AddItemsToReceptionResponse
+}
+
+func (s *batchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *batchStream) Recv() (*gen.AddItemsToReceptionRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *batchStream) SendAndClose(res *gen.AddItemsToReceptionResponse) error {
+	s.res = res
+	return nil
+}
+
+func TestHandler_AddItemsToReception(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+	scannedAt := time.Now().Add(-time.Minute).UTC()
+	reqs := func() []*gen.AddItemsToReceptionRequest {
+		return []*gen.AddItemsToReceptionRequest{
+			{PvzId: pvzID.String(), Type: "обувь", ScannedAt: timestamppb.New(scannedAt)},
+			{PvzId: pvzID.String(), Type: "unknown"},
+		}
+	}
+	batch := &dto.ProductsBatchPostReq{
+		PvzId: pvzID,
+		Items: []dto.ProductBatchItem{
+			{Type: "обувь", ScannedAt: dto.NewOptDateTime(scannedAt)},
+			{Type: "unknown"},
+		},
+	}
+
+	t.Run(
+		"InvalidUUID", func(t *testing.T) {
+			err := h.AddItemsToReception(
+				&batchStream{
+					ctx:  context.Background(),
+					reqs: []*gen.AddItemsToReceptionRequest{{PvzId: "wrong", Type: "обувь"}},
+				},
+			)
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		},
+	)
+
+	t.Run(
+		"MixedPVZ", func(t *testing.T) {
+			err := h.AddItemsToReception(
+				&batchStream{
+					ctx:  context.Background(),
+					reqs: append(reqs(), &gen.AddItemsToReceptionRequest{PvzId: uuid.NewString(), Type: "обувь"}),
+				},
+			)
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		},
+	)
+
+	t.Run(
+		"EmptyStream", func(t *testing.T) {
+			mctrl.EXPECT().
+				AddItemsToReception(gomock.Any(), &dto.ProductsBatchPostReq{}).
+				Return(nil, ctrl.ErrInvalidBatchSize)
+
+			err := h.AddItemsToReception(&batchStream{ctx: context.Background()})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		},
+	)
+
+	t.Run(
+		"NoActiveReception", func(t *testing.T) {
+			mctrl.EXPECT().AddItemsToReception(gomock.Any(), batch).Return(nil, ctrl.ErrNoActiveReception)
+
+			err := h.AddItemsToReception(&batchStream{ctx: context.Background(), reqs: reqs()})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.FailedPrecondition, st.Code())
+		},
+	)
+
+	t.Run(
+		"InternalError", func(t *testing.T) {
+			mctrl.EXPECT().AddItemsToReception(gomock.Any(), batch).Return(nil, testErr)
+
+			err := h.AddItemsToReception(&batchStream{ctx: context.Background(), reqs: reqs()})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.Internal, st.Code())
+		},
+	)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			productID := uuid.New()
+			mctrl.EXPECT().
+				AddItemsToReception(gomock.Any(), batch).
+				Return(
+					&dto.ProductBatch{
+						Added:  1,
+						Failed: 1,
+						Results: []dto.ProductBatchResult{
+							{Index: 0, Product: dto.NewOptProduct(dto.Product{ID: dto.NewOptUUID(productID), Type: "обувь"})},
+							{Index: 1, Error: dto.NewOptString(ctrl.ErrTypeIsNotValid.Error())},
+						},
+					}, nil,
+				)
+
+			stream := &batchStream{ctx: context.Background(), reqs: reqs()}
+			err := h.AddItemsToReception(stream)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), stream.res.Added)
+			assert.Equal(t, productID.String(), stream.res.Results[0].Product.Id)
+			assert.Equal(t, ctrl.ErrTypeIsNotValid.Error(), stream.res.Results[1].Error)
+		},
+	)
+}
+
+func TestHandler_DeleteLastProduct(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		req        *gen.DeleteLastProductRequest
+		expect     func()
+		assertions func(*gen.DeleteLastProductResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.DeleteLastProductResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:   "InvalidUUID",
+			req:    &gen.DeleteLastProductRequest{PvzId: "wrong"},
+			expect: func() {},
+			assertions: func(res *gen.DeleteLastProductResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ErrNoItems",
+			req:  &gen.DeleteLastProductRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().DeleteLastProduct(gomock.Any(), pvzID).Return(ctrl.ErrNoItems)
+			},
+			assertions: func(res *gen.DeleteLastProductResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrNoItems.Error(), st.Message())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.DeleteLastProductRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().DeleteLastProduct(gomock.Any(), pvzID).Return(testErr)
+			},
+			assertions: func(res *gen.DeleteLastProductResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.DeleteLastProductRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().DeleteLastProduct(gomock.Any(), pvzID).Return(nil)
+			},
+			assertions: func(res *gen.DeleteLastProductResponse, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.DeleteLastProduct(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_UndoLastDelete(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+	productID := uuid.New()
+
+	tests := []struct {
+		name       string
+		req        *gen.UndoLastDeleteRequest
+		expect     func()
+		assertions func(*gen.UndoLastDeleteResponse, error)
+	}{
+		{
+			name:   "InvalidUUID",
+			req:    &gen.UndoLastDeleteRequest{PvzId: "wrong"},
+			expect: func() {},
+			assertions: func(res *gen.UndoLastDeleteResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ErrNoDeletedItems",
+			req:  &gen.UndoLastDeleteRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().UndoLastDelete(gomock.Any(), pvzID).Return(nil, ctrl.ErrNoDeletedItems)
+			},
+			assertions: func(res *gen.UndoLastDeleteResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrNoDeletedItems.Error(), st.Message())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.UndoLastDeleteRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().UndoLastDelete(gomock.Any(), pvzID).Return(nil, testErr)
+			},
+			assertions: func(res *gen.UndoLastDeleteResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.UndoLastDeleteRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().
+					UndoLastDelete(gomock.Any(), pvzID).
+					Return(&dto.Product{ID: dto.NewOptUUID(productID), Type: "обувь", ReceptionId: uuid.New()}, nil)
+			},
+			assertions: func(res *gen.UndoLastDeleteResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, productID.String(), res.Product.Id)
+				assert.Nil(t, res.Product.DeletedAt)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.UndoLastDelete(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+func TestHandler_CloseLastReception(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name       string
+		req        *gen.CloseLastReceptionRequest
+		expect     func()
+		assertions func(*gen.CloseLastReceptionResponse, error)
+	}{
+		{
+			name:   "NilRequest",
+			req:    nil,
+			expect: func() {},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name:   "InvalidUUID",
+			req:    &gen.CloseLastReceptionRequest{PvzId: uuid.Nil.String()},
+			expect: func() {},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ErrReceptionAlreadyClosed",
+			req:  &gen.CloseLastReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(nil, ctrl.ErrReceptionAlreadyClosed)
+			},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+				assert.Equal(t, ctrl.ErrReceptionAlreadyClosed.Error(), st.Message())
+			},
+		},
+		{
+			name: "ErrManifestDiscrepancies",
+			req:  &gen.CloseLastReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(nil, ctrl.ErrManifestDiscrepancies)
+			},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &gen.CloseLastReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(nil, testErr)
+			},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.Nil(t, res)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "Success",
+			req:  &gen.CloseLastReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(
+					&dto.Reception{
+						ID:       dto.OptUUID{Set: true, Value: uuid.New()},
+						DateTime: time.Now(),
+						PvzId:    pvzID,
+						Status:   "closed",
+					}, nil,
+				)
+			},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, gen.ReceptionStatus_RECEPTION_STATUS_CLOSED, res.Reception.Status)
+				assert.Nil(t, res.Discrepancies)
+			},
+		},
+		{
+			name: "SuccessWithDiscrepancies",
+			req:  &gen.CloseLastReceptionRequest{PvzId: pvzID.String()},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), pvzID).Return(
+					&dto.Reception{
+						ID:     dto.OptUUID{Set: true, Value: uuid.New()},
+						PvzId:  pvzID,
+						Status: "closed",
+						Discrepancies: dto.NewOptDiscrepancyReport(
+							dto.DiscrepancyReport{
+								Unexpected: []dto.DiscrepancyLine{{Type: dto.NewOptString("обувь"), Actual: 1}},
+							},
+						),
+					}, nil,
+				)
+			},
+			assertions: func(res *gen.CloseLastReceptionResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, res.Discrepancies.Unexpected, 1)
+				assert.Equal(t, int64(1), res.Discrepancies.Unexpected[0].Actual)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				res, err := h.CloseLastReception(context.Background(), tt.req)
+				tt.assertions(res, err)
+			},
+		)
+	}
+}
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*gen.PVZEvent
+	err  error
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(e *gen.PVZEvent) error {
+	s.sent = append(s.sent, e)
+	return s.err
+}
+
+func TestHandler_WatchPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	testErr := errors.New("test error")
+	mctrl := mocks.NewMockAppCtrl(mock)
+	h := New("test-svc", mctrl, mocks.NewMockCore(mock))
+
+	pvzID := uuid.New()
+
+	t.Run(
+		"NilRequest", func(t *testing.T) {
+			err := h.WatchPVZ(nil, &watchStream{ctx: context.Background()})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		},
+	)
+
+	t.Run(
+		"InvalidUUID", func(t *testing.T) {
+			err := h.WatchPVZ(&gen.WatchPVZRequest{PvzId: "wrong"}, &watchStream{ctx: context.Background()})
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, ErrFailedToParseUUID.Error(), st.Message())
+		},
+	)
+
+	t.Run(
+		"SendError", func(t *testing.T) {
+			events := make(chan *md.Event, 1)
+			events <- &md.Event{Type: md.EventProductAdded, PVZID: pvzID}
+			mctrl.EXPECT().WatchPVZ(gomock.Any(), pvzID, "").Return(events, func() {})
+
+			stream := &watchStream{ctx: context.Background(), err: testErr}
+			err := h.WatchPVZ(&gen.WatchPVZRequest{PvzId: pvzID.String()}, stream)
+			assert.ErrorIs(t, err, testErr)
+		},
+	)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			events := make(chan *md.Event, 2)
+			events <- &md.Event{Type: md.EventReceptionOpened, PVZID: pvzID, City: "Казань"}
+			events <- &md.Event{Type: md.EventReceptionClosed, PVZID: pvzID, City: "Казань"}
+			close(events)
+
+			unsubscribed := false
+			mctrl.EXPECT().WatchPVZ(gomock.Any(), uuid.Nil, "Казань").Return(events, func() { unsubscribed = true })
+
+			stream := &watchStream{ctx: context.Background()}
+			err := h.WatchPVZ(&gen.WatchPVZRequest{City: "Казань"}, stream)
+			assert.NoError(t, err)
+			assert.True(t, unsubscribed)
+			assert.Len(t, stream.sent, 2)
+			assert.Equal(t, gen.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED, stream.sent[0].Type)
+			assert.Equal(t, gen.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED, stream.sent[1].Type)
+		},
+	)
+
+	t.Run(
+		"ContextDone", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			mctrl.EXPECT().WatchPVZ(gomock.Any(), uuid.Nil, "").Return(make(chan *md.Event), func() {})
+
+			err := h.WatchPVZ(&gen.WatchPVZRequest{}, &watchStream{ctx: ctx})
+			assert.NoError(t, err)
+		},
+	)
+}
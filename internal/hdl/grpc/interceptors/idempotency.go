@@ -0,0 +1,188 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/ctrl"
+	"github.com/JMURv/avito-spring/internal/hdl"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"slices"
+)
+
+const IdempotencyKeyMetadata = "idempotency-key"
+
+// maxIdempotencyKeyLen matches the key column of the idempotency_keys table.
+const maxIdempotencyKeyLen = 255
+
+var ErrInvalidIdempotencyKey = errors.New("idempotency key must not exceed 255 characters")
+var ErrIdempotencyKeyNotSupported = errors.New("idempotency key is not supported for streaming methods")
+
+// Idempotency is the gRPC counterpart of the HTTP Idempotency-Key header for the listed unary methods.
+// It must be chained after AuthUnary, as keys are scoped to the authenticated user. Calls failing
+// with a server side code are not stored and may be retried with the same key.
+func Idempotency(c ctrl.AppCtrl, methods []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		meta, ok := metadata.FromIncomingContext(ctx)
+		if !ok || len(meta.Get(IdempotencyKeyMetadata)) == 0 || meta.Get(IdempotencyKeyMetadata)[0] == "" {
+			return handler(ctx, req)
+		}
+
+		key := meta.Get(IdempotencyKeyMetadata)[0]
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Errorf(codes.InvalidArgument, ErrInvalidIdempotencyKey.Error())
+		}
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+		}
+
+		hash, err := hashRequest(info.FullMethod, msg)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, hdl.ErrDecodeRequest.Error())
+		}
+
+		k := &md.IdempotencyKey{Key: key, RequestHash: hash}
+		stored, err := c.BeginIdempotent(ctx, k)
+		if err != nil {
+			if errors.Is(err, ctrl.ErrIdempotencyKeyReused) {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if errors.Is(err, ctrl.ErrIdempotencyKeyInProgress) {
+				return nil, status.Errorf(codes.Aborted, err.Error())
+			}
+			return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+		}
+
+		if stored != nil {
+			return replay(stored)
+		}
+
+		res, err := handler(ctx, req)
+		finish(context.WithoutCancel(ctx), c, k, res, err)
+		return res, err
+	}
+}
+
+// RejectIdempotencyKey fails the listed streaming methods when the idempotency-key metadata is sent. Their requests
+// are only known once the stream is drained, so a key could not be honoured and the client must not assume it was.
+func RejectIdempotencyKey(methods []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		meta, ok := metadata.FromIncomingContext(ss.Context())
+		if ok && len(meta.Get(IdempotencyKeyMetadata)) > 0 && meta.Get(IdempotencyKeyMetadata)[0] != "" {
+			return status.Errorf(codes.InvalidArgument, ErrIdempotencyKeyNotSupported.Error())
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// finish stores the outcome of the call for replay. The client may be gone by now, so ctx must not be canceled.
+func finish(ctx context.Context, c ctrl.AppCtrl, k *md.IdempotencyKey, res any, callErr error) {
+	if retryable(status.Code(callErr)) {
+		if err := c.ReleaseIdempotent(ctx, k); err != nil {
+			zap.L().Warn("Failed to release idempotency key", zap.String("key", k.Key), zap.Error(err))
+		}
+		return
+	}
+
+	if err := record(k, res, callErr); err != nil {
+		zap.L().Error("Failed to encode idempotent response", zap.String("key", k.Key), zap.Error(err))
+		if err = c.ReleaseIdempotent(ctx, k); err != nil {
+			zap.L().Warn("Failed to release idempotency key", zap.String("key", k.Key), zap.Error(err))
+		}
+		return
+	}
+
+	if err := c.CompleteIdempotent(ctx, k); err != nil {
+		zap.L().Warn("Failed to store idempotent response", zap.String("key", k.Key), zap.Error(err))
+	}
+}
+
+func hashRequest(method string, req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// record stores the outcome of a call in k. Errors keep their code and message, responses are kept as Any
+// so they can be decoded without knowing the method.
+func record(k *md.IdempotencyKey, res any, callErr error) error {
+	if callErr != nil {
+		st := status.Convert(callErr)
+		k.StatusCode = int(st.Code())
+		k.Response = []byte(st.Message())
+		return nil
+	}
+
+	msg, ok := res.(proto.Message)
+	if !ok {
+		return hdl.ErrInternal
+	}
+
+	a, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+
+	b, err := proto.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	k.StatusCode = int(codes.OK)
+	k.Response = b
+	return nil
+}
+
+func replay(k *md.IdempotencyKey) (any, error) {
+	if code := codes.Code(k.StatusCode); code != codes.OK {
+		return nil, status.Error(code, string(k.Response))
+	}
+
+	a := &anypb.Any{}
+	if err := proto.Unmarshal(k.Response, a); err != nil {
+		zap.L().Error("Failed to decode idempotent response", zap.String("key", k.Key), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+
+	res, err := a.UnmarshalNew()
+	if err != nil {
+		zap.L().Error("Failed to decode idempotent response", zap.String("key", k.Key), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, hdl.ErrInternal.Error())
+	}
+	return res, nil
+}
+
+func retryable(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,213 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/ctrl"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"strings"
+	"testing"
+)
+
+func TestIdempotency(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	interceptor := Idempotency(mctrl, []string{anyRoleMethod})
+	testErr := errors.New("test error")
+	req := wrapperspb.String("request")
+	resp := wrapperspb.String("response")
+
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMetadata, key))
+	}
+
+	stored := &md.IdempotencyKey{Key: "key", Completed: true}
+	assert.NoError(t, record(stored, resp, nil))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		method  string
+		handler func() (any, error)
+		expect  func()
+		called  bool
+		code    codes.Code
+	}{
+		{
+			name:    "NotIdempotentMethod",
+			ctx:     withKey("key"),
+			method:  publicMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect:  func() {},
+			called:  true,
+		},
+		{
+			name:    "NoKey",
+			ctx:     context.Background(),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect:  func() {},
+			called:  true,
+		},
+		{
+			name:    "KeyTooLong",
+			ctx:     withKey(strings.Repeat("k", 256)),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect:  func() {},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "Reused",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrIdempotencyKeyReused)
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name:    "InProgress",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrIdempotencyKeyInProgress)
+			},
+			code: codes.Aborted,
+		},
+		{
+			name:    "Replay",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(stored, nil)
+			},
+		},
+		{
+			name:    "ReplayError",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return resp, nil },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(
+					&md.IdempotencyKey{StatusCode: int(codes.FailedPrecondition), Response: []byte("no active reception")}, nil,
+				)
+			},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name:    "Complete",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return nil, status.Errorf(codes.FailedPrecondition, testErr.Error()) },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mctrl.EXPECT().CompleteIdempotent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, k *md.IdempotencyKey) error {
+						assert.Equal(t, int(codes.FailedPrecondition), k.StatusCode)
+						assert.Equal(t, testErr.Error(), string(k.Response))
+						return nil
+					},
+				)
+			},
+			called: true,
+			code:   codes.FailedPrecondition,
+		},
+		{
+			name:    "Release",
+			ctx:     withKey("key"),
+			method:  anyRoleMethod,
+			handler: func() (any, error) { return nil, status.Errorf(codes.Internal, testErr.Error()) },
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mctrl.EXPECT().ReleaseIdempotent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			called: true,
+			code:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				called := false
+				res, err := interceptor(
+					tt.ctx, req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+					func(ctx context.Context, req any) (any, error) {
+						called = true
+						return tt.handler()
+					},
+				)
+				assert.Equal(t, tt.called, called)
+				assert.Equal(t, tt.code, status.Code(err))
+				if tt.code == codes.OK {
+					assert.True(t, proto.Equal(resp, res.(proto.Message)))
+				}
+			},
+		)
+	}
+}
+
+func TestRejectIdempotencyKey(t *testing.T) {
+	interceptor := RejectIdempotencyKey([]string{anyRoleMethod})
+	withKey := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMetadata, "key"))
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		called bool
+		code   codes.Code
+	}{
+		{
+			name:   "OtherMethod",
+			ctx:    withKey,
+			method: publicMethod,
+			called: true,
+		},
+		{
+			name:   "NoKey",
+			ctx:    context.Background(),
+			method: anyRoleMethod,
+			called: true,
+		},
+		{
+			name:   "Rejected",
+			ctx:    withKey,
+			method: anyRoleMethod,
+			code:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				called := false
+				err := interceptor(
+					nil, &testStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method},
+					func(srv any, ss grpc.ServerStream) error {
+						called = true
+						return nil
+					},
+				)
+				assert.Equal(t, tt.called, called)
+				assert.Equal(t, tt.code, status.Code(err))
+			},
+		)
+	}
+}
@@ -0,0 +1,98 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+)
+
+var ErrNotAuthorized = errors.New("not authorized")
+var ErrAuthMetadataIsMissing = errors.New("authorization metadata is missing")
+var ErrInvalidTokenFormat = errors.New("invalid token format")
+
+// AuthUnary authenticates unary calls listed in roles, an empty role list admits any authenticated user.
+// Methods listed in public are served without authentication, any other method is rejected.
+func AuthUnary(au auth.Core, roles map[string][]string, public []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(public, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		allowed, ok := roles[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, ErrNotAuthorized.Error())
+		}
+
+		ctx, err := authorize(ctx, au, allowed)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStream is the streaming counterpart of AuthUnary.
+func AuthStream(au auth.Core, roles map[string][]string, public []string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if slices.Contains(public, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		allowed, ok := roles[info.FullMethod]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, ErrNotAuthorized.Error())
+		}
+
+		ctx, err := authorize(ss.Context(), au, allowed)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func authorize(ctx context.Context, au auth.Core, allowedRole []string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, ErrAuthMetadataIsMissing.Error())
+	}
+
+	header := md.Get("authorization")[0]
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header {
+		return nil, status.Errorf(codes.Unauthenticated, ErrInvalidTokenFormat.Error())
+	}
+
+	claims, err := au.ParseClaims(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+	}
+
+	if len(allowedRole) > 0 {
+		if !slices.Contains(allowedRole, claims.Role) {
+			return nil, status.Errorf(codes.PermissionDenied, ErrNotAuthorized.Error())
+		}
+	}
+
+	ctx = context.WithValue(ctx, "role", claims.Role)
+	ctx = context.WithValue(ctx, "uid", claims.UID)
+	ctx = context.WithValue(ctx, "jti", claims.ID)
+	return ctx, nil
+}
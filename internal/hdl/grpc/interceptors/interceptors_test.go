@@ -0,0 +1,241 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/auth"
+	"github.com/JMURv/avito-spring/tests/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+const (
+	publicMethod    = "/svc/Public"
+	unknownMethod   = "/svc/Unknown"
+	anyRoleMethod   = "/svc/AnyRole"
+	moderatorMethod = "/svc/Moderator"
+)
+
+var testRoles = map[string][]string{
+	anyRoleMethod:   {},
+	moderatorMethod: {"moderator"},
+}
+
+var testPublic = []string{publicMethod}
+
+func TestAuthUnary(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	au := mocks.NewMockCore(mock)
+	interceptor := AuthUnary(au, testRoles, testPublic)
+	testErr := errors.New("test error")
+	uid := uuid.New()
+
+	withToken := func(header string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", header))
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		method     string
+		expect     func()
+		assertions func(ctx context.Context, called bool, err error)
+	}{
+		{
+			name:   "PublicMethod",
+			ctx:    context.Background(),
+			method: publicMethod,
+			expect: func() {},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.NoError(t, err)
+				assert.True(t, called)
+			},
+		},
+		{
+			name:   "UnknownMethod",
+			ctx:    withToken("Bearer token"),
+			method: unknownMethod,
+			expect: func() {},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.False(t, called)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:   "MissingMetadata",
+			ctx:    context.Background(),
+			method: anyRoleMethod,
+			expect: func() {},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.False(t, called)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Unauthenticated, st.Code())
+				assert.Equal(t, ErrAuthMetadataIsMissing.Error(), st.Message())
+			},
+		},
+		{
+			name:   "InvalidTokenFormat",
+			ctx:    withToken("token"),
+			method: anyRoleMethod,
+			expect: func() {},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.False(t, called)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Unauthenticated, st.Code())
+				assert.Equal(t, ErrInvalidTokenFormat.Error(), st.Message())
+			},
+		},
+		{
+			name:   "ParseClaimsError",
+			ctx:    withToken("Bearer token"),
+			method: anyRoleMethod,
+			expect: func() {
+				au.EXPECT().ParseClaims(gomock.Any(), "token").Return(auth.Claims{}, testErr)
+			},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.False(t, called)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.Unauthenticated, st.Code())
+				assert.Equal(t, testErr.Error(), st.Message())
+			},
+		},
+		{
+			name:   "RoleNotAllowed",
+			ctx:    withToken("Bearer token"),
+			method: moderatorMethod,
+			expect: func() {
+				au.EXPECT().ParseClaims(gomock.Any(), "token").Return(auth.Claims{UID: uid, Role: "employee"}, nil)
+			},
+			assertions: func(_ context.Context, called bool, err error) {
+				assert.False(t, called)
+				st, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.PermissionDenied, st.Code())
+				assert.Equal(t, ErrNotAuthorized.Error(), st.Message())
+			},
+		},
+		{
+			name:   "Success",
+			ctx:    withToken("Bearer token"),
+			method: moderatorMethod,
+			expect: func() {
+				au.EXPECT().ParseClaims(gomock.Any(), "token").Return(auth.Claims{UID: uid, Role: "moderator"}, nil)
+			},
+			assertions: func(ctx context.Context, called bool, err error) {
+				assert.NoError(t, err)
+				assert.True(t, called)
+				assert.Equal(t, uid, ctx.Value("uid"))
+				assert.Equal(t, "moderator", ctx.Value("role"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+
+				var called bool
+				var hctx context.Context
+				_, err := interceptor(
+					tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+					func(ctx context.Context, req any) (any, error) {
+						called = true
+						hctx = ctx
+						return nil, nil
+					},
+				)
+				tt.assertions(hctx, called, err)
+			},
+		)
+	}
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStream(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	au := mocks.NewMockCore(mock)
+	interceptor := AuthStream(au, testRoles, testPublic)
+	uid := uuid.New()
+
+	t.Run(
+		"PublicMethod", func(t *testing.T) {
+			called := false
+			err := interceptor(
+				nil, &testStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: publicMethod},
+				func(srv any, ss grpc.ServerStream) error {
+					called = true
+					return nil
+				},
+			)
+			assert.NoError(t, err)
+			assert.True(t, called)
+		},
+	)
+
+	t.Run(
+		"UnknownMethod", func(t *testing.T) {
+			err := interceptor(
+				nil, &testStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: unknownMethod},
+				func(srv any, ss grpc.ServerStream) error {
+					t.Fatal("handler must not be called")
+					return nil
+				},
+			)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		},
+	)
+
+	t.Run(
+		"MissingMetadata", func(t *testing.T) {
+			err := interceptor(
+				nil, &testStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: anyRoleMethod},
+				func(srv any, ss grpc.ServerStream) error {
+					t.Fatal("handler must not be called")
+					return nil
+				},
+			)
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.Unauthenticated, st.Code())
+		},
+	)
+
+	t.Run(
+		"Success", func(t *testing.T) {
+			au.EXPECT().ParseClaims(gomock.Any(), "token").Return(auth.Claims{UID: uid, Role: "employee"}, nil)
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+			err := interceptor(
+				nil, &testStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: anyRoleMethod},
+				func(srv any, ss grpc.ServerStream) error {
+					assert.Equal(t, uid, ss.Context().Value("uid"))
+					assert.Equal(t, "employee", ss.Context().Value("role"))
+					return nil
+				},
+			)
+			assert.NoError(t, err)
+		},
+	)
+}
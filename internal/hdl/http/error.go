@@ -4,3 +4,7 @@ import "errors"
 
 var ErrInvalidPathSegments = errors.New("missing or invalid path segments")
 var ErrFailedToParseUUID = errors.New("failed to parse uuid")
+var ErrInvalidDate = errors.New("invalid date format, use RFC3339")
+var ErrBarcodeIsMissing = errors.New("barcode query parameter is required")
+var ErrInvalidManifestCSV = errors.New("invalid manifest csv, expected a header with quantity and type or barcode columns")
+var ErrInvalidNearbyQuery = errors.New("lat, lon and radius query parameters must be numbers")
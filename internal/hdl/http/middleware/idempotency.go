@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/JMURv/avito-spring/internal/ctrl"
+	"github.com/JMURv/avito-spring/internal/hdl"
+	"github.com/JMURv/avito-spring/internal/hdl/http/utils"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// maxIdempotencyKeyLen matches the key column of the idempotency_keys table.
+const maxIdempotencyKeyLen = 255
+
+var ErrInvalidIdempotencyKey = errors.New("idempotency key must not exceed 255 characters")
+
+// Idempotency replays the stored response of a request repeated with the same Idempotency-Key header.
+// Keys are scoped to the authenticated user, so the middleware must run after Auth, anonymous requests
+// are served as if no key was sent. Responses with a 5xx status are not stored and the request may be
+// retried with the same key.
+func Idempotency(c ctrl.AppCtrl) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				key := r.Header.Get(IdempotencyKeyHeader)
+				if uid, _ := r.Context().Value("uid").(uuid.UUID); key == "" || uid == uuid.Nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				if len(key) > maxIdempotencyKeyLen {
+					utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidIdempotencyKey)
+					return
+				}
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					utils.ErrResponse(w, http.StatusBadRequest, hdl.ErrDecodeRequest)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+
+				k := &md.IdempotencyKey{
+					Key:         key,
+					RequestHash: hashRequest(r.Method, r.URL.Path, r.URL.RawQuery, body),
+				}
+				stored, err := c.BeginIdempotent(r.Context(), k)
+				if err != nil {
+					if errors.Is(err, ctrl.ErrIdempotencyKeyReused) {
+						utils.ErrResponse(w, http.StatusUnprocessableEntity, err)
+						return
+					}
+					if errors.Is(err, ctrl.ErrIdempotencyKeyInProgress) {
+						utils.ErrResponse(w, http.StatusConflict, err)
+						return
+					}
+					utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+					return
+				}
+
+				if stored != nil {
+					if stored.ContentType != "" {
+						w.Header().Set("Content-Type", stored.ContentType)
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					utils.TextResponse(w, stored.StatusCode, stored.Response)
+					return
+				}
+
+				rec := &recordingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+				next.ServeHTTP(rec, r)
+
+				// The client may be gone by now, the outcome is stored anyway so that its retry is replayed.
+				ctx := context.WithoutCancel(r.Context())
+				k.StatusCode = rec.statusCode
+				k.ContentType = rec.Header().Get("Content-Type")
+				k.Response = rec.body.Bytes()
+				if rec.statusCode >= http.StatusInternalServerError {
+					err = c.ReleaseIdempotent(ctx, k)
+				} else {
+					err = c.CompleteIdempotent(ctx, k)
+				}
+				if err != nil {
+					zap.L().Warn("Failed to finish idempotent request", zap.String("key", key), zap.Error(err))
+				}
+			},
+		)
+	}
+}
+
+func hashRequest(method, path, query string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingResponseWriter keeps a copy of the response so it can be stored for replay.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(code int) {
+	w.statusCode = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recordingResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
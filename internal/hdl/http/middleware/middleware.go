@@ -48,6 +48,7 @@ func Auth(au auth.Core, allowedRole ...string) func(http.Handler) http.Handler {
 
 				ctx := context.WithValue(r.Context(), "role", claims.Role)
 				ctx = context.WithValue(ctx, "uid", claims.UID)
+				ctx = context.WithValue(ctx, "jti", claims.ID)
 				next.ServeHTTP(w, r.WithContext(ctx))
 			},
 		)
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/csv"
 	"errors"
 	"github.com/JMURv/avito-spring/internal/auth"
 	"github.com/JMURv/avito-spring/internal/ctrl"
@@ -12,6 +13,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
@@ -25,25 +28,82 @@ func (h *Handler) RegisterRoutes() {
 		},
 	)
 
+	// Every authenticated POST endpoint honours the Idempotency-Key header. Token issuing ones are left out,
+	// their responses are credentials and must not be stored, and anonymous callers would share a key scope.
+	idem := mid.Idempotency(h.ctrl)
+
+	h.Router.Get("/.well-known/jwks.json", h.jwks)
 	h.Router.Post("/dummyLogin", h.dummyLogin)
 	h.Router.Post("/register", h.register)
 	h.Router.Post("/login", h.login)
+	h.Router.Post("/refresh", h.refresh)
+	h.Router.With(mid.Auth(h.au), idem).Post("/logout", h.logout)
 	h.Router.Route(
 		"/pvz", func(r chi.Router) {
 			r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/", h.getPVZ)
-			r.With(mid.Auth(h.au, md.ModeratorRole)).Post("/", h.createPVZ)
+			r.With(mid.Auth(h.au, md.ModeratorRole), idem).Post("/", h.createPVZ)
+			r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/nearby", h.findNearbyPVZ)
 
 			r.Route(
 				"/{id}", func(r chi.Router) {
-					r.With(mid.Auth(h.au)).Post("/close_last_reception", h.closeLastReception)
-					r.With(mid.Auth(h.au, md.EmployeeRole)).Post("/delete_last_product", h.deleteLastProduct)
+					r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/", h.getPVZByID)
+					r.With(mid.Auth(h.au, md.ModeratorRole), idem).Patch("/", h.updatePVZ)
+					r.With(mid.Auth(h.au, md.ModeratorRole), idem).Post("/decommission", h.decommissionPVZ)
+					r.With(mid.Auth(h.au), idem).Post("/close_last_reception", h.closeLastReception)
+					r.With(mid.Auth(h.au, md.EmployeeRole), idem).Post("/delete_last_product", h.deleteLastProduct)
+					r.With(mid.Auth(h.au, md.EmployeeRole), idem).Post("/undo_last_delete", h.undoLastDelete)
 				},
 			)
 		},
 	)
 
-	h.Router.With(mid.Auth(h.au, md.EmployeeRole)).Post("/receptions", h.createReception)
-	h.Router.With(mid.Auth(h.au, md.EmployeeRole)).Post("/products", h.addItemToReception)
+	h.Router.Route(
+		"/receptions", func(r chi.Router) {
+			r.With(mid.Auth(h.au, md.EmployeeRole), idem).Post("/", h.createReception)
+			r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/{id}/summary", h.getReceptionSummary)
+			r.With(mid.Auth(h.au, md.EmployeeRole)).Delete("/{id}/products/{productId}", h.deleteProduct)
+			r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/{id}/manifest", h.getManifest)
+			r.With(mid.Auth(h.au, md.EmployeeRole)).Put("/{id}/manifest", h.uploadManifest)
+			r.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/{id}/discrepancies", h.getDiscrepancies)
+			r.With(mid.Auth(h.au, md.ModeratorRole), idem).Post("/{id}/reopen", h.reopenReception)
+		},
+	)
+	h.Router.With(mid.Auth(h.au, md.ModeratorRole, md.EmployeeRole)).Get("/products", h.findProducts)
+	h.Router.With(mid.Auth(h.au, md.EmployeeRole), idem).Post("/products", h.addItemToReception)
+	h.Router.With(mid.Auth(h.au, md.EmployeeRole), idem).Post("/products/batch", h.addItemsToReception)
+
+	h.Router.Route(
+		"/cities", func(r chi.Router) {
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Get("/", h.listCities)
+			r.With(mid.Auth(h.au, md.ModeratorRole), idem).Post("/", h.createCity)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Put("/{name}", h.setCityActive)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Delete("/{name}", h.deleteCity)
+		},
+	)
+
+	h.Router.Route(
+		"/product-types", func(r chi.Router) {
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Get("/", h.listProductTypes)
+			r.With(mid.Auth(h.au, md.ModeratorRole), idem).Post("/", h.createProductType)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Put("/{name}", h.setProductTypeActive)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Delete("/{name}", h.deleteProductType)
+		},
+	)
+
+	h.Router.Route(
+		"/users/{userId}/pvz", func(r chi.Router) {
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Get("/", h.listAssignments)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Put("/{pvzId}", h.assignPVZ)
+			r.With(mid.Auth(h.au, md.ModeratorRole)).Delete("/{pvzId}", h.unassignPVZ)
+		},
+	)
+
+	h.Router.With(mid.Auth(h.au, md.ModeratorRole)).Get("/audit", h.listAudit)
+}
+
+func (h *Handler) jwks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	utils.SuccessResponse(w, http.StatusOK, h.au.JWKS())
 }
 
 func (h *Handler) dummyLogin(w http.ResponseWriter, r *http.Request) {
@@ -100,7 +160,7 @@ func (h *Handler) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.ctrl.Login(r.Context(), req)
+	res, refresh, err := h.ctrl.Login(r.Context(), req)
 	if err != nil {
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			utils.ErrResponse(w, http.StatusUnauthorized, err)
@@ -110,9 +170,53 @@ func (h *Handler) login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("X-Refresh-Token", refresh)
+	utils.TextResponse(w, http.StatusOK, []byte(res))
+}
+
+func (h *Handler) refresh(w http.ResponseWriter, r *http.Request) {
+	req := &dto.RefreshPostReq{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, refresh, err := h.ctrl.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+			utils.ErrResponse(w, http.StatusUnauthorized, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	w.Header().Set("X-Refresh-Token", refresh)
 	utils.TextResponse(w, http.StatusOK, []byte(res))
 }
 
+func (h *Handler) logout(w http.ResponseWriter, r *http.Request) {
+	req := &dto.LogoutPostReq{}
+	if r.ContentLength != 0 {
+		if err := utils.Parse(r, req); err != nil {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if err := h.ctrl.Logout(r.Context(), req.RefreshToken.Value); err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
 func (h *Handler) getPVZ(w http.ResponseWriter, r *http.Request) {
 	page, err := strconv.ParseInt(r.URL.Query().Get("page"), 10, 64)
 	if err != nil || page < 1 {
@@ -143,12 +247,20 @@ func (h *Handler) getPVZ(w http.ResponseWriter, r *http.Request) {
 		)
 	}
 
-	res, err := h.ctrl.GetPVZ(r.Context(), page, limit, startDate, endDate)
+	includeDeleted, _ := strconv.ParseBool(r.URL.Query().Get("includeDeleted"))
+	res, next, err := h.ctrl.GetPVZ(r.Context(), page, limit, r.URL.Query().Get("cursor"), startDate, endDate, includeDeleted)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrInvalidCursor) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
 
+	if next != "" {
+		w.Header().Set("X-Next-Cursor", next)
+	}
 	utils.SuccessResponse(w, http.StatusOK, res)
 }
 
@@ -166,6 +278,10 @@ func (h *Handler) createPVZ(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.CreatePVZ(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrCityIsNotValid) || isPVZValidationErr(err) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
@@ -173,6 +289,128 @@ func (h *Handler) createPVZ(w http.ResponseWriter, r *http.Request) {
 	utils.SuccessResponse(w, http.StatusCreated, res)
 }
 
+func (h *Handler) findNearbyPVZ(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	lat, err := strconv.ParseFloat(q.Get("lat"), 64)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidNearbyQuery)
+		return
+	}
+
+	lon, err := strconv.ParseFloat(q.Get("lon"), 64)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidNearbyQuery)
+		return
+	}
+
+	radius, err := strconv.ParseFloat(q.Get("radius"), 64)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidNearbyQuery)
+		return
+	}
+
+	limit, err := strconv.ParseInt(q.Get("limit"), 10, 64)
+	if err != nil || limit < 1 {
+		limit = 0
+	}
+
+	openNow, _ := strconv.ParseBool(q.Get("openNow"))
+	res, err := h.ctrl.FindNearbyPVZ(r.Context(), lat, lon, radius, openNow, limit)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCoordinatesIsNotValid) || errors.Is(err, ctrl.ErrRadiusIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) getPVZByID(w http.ResponseWriter, r *http.Request) {
+	pvzID, err := parsePVZPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.GetPVZByID(r.Context(), pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) updatePVZ(w http.ResponseWriter, r *http.Request) {
+	pvzID, err := parsePVZPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	req := &dto.PVZUpdate{}
+	if err = utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.UpdatePVZ(r.Context(), pvzID, req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrNothingToUpdate) || errors.Is(err, ctrl.ErrCityIsNotValid) || isPVZValidationErr(err) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrPVZNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrPVZClosed) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) decommissionPVZ(w http.ResponseWriter, r *http.Request) {
+	pvzID, err := parsePVZPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.DecommissionPVZ(r.Context(), pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrPVZClosed) || errors.Is(err, ctrl.ErrReceptionStillOpen) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
 func (h *Handler) closeLastReception(w http.ResponseWriter, r *http.Request) {
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) != 4 {
@@ -188,10 +426,18 @@ func (h *Handler) closeLastReception(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.CloseLastReception(r.Context(), pvzID)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		if errors.Is(err, ctrl.ErrReceptionAlreadyClosed) {
 			utils.ErrResponse(w, http.StatusBadRequest, err)
 			return
 		}
+		if errors.Is(err, ctrl.ErrManifestDiscrepancies) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
@@ -215,6 +461,10 @@ func (h *Handler) deleteLastProduct(w http.ResponseWriter, r *http.Request) {
 
 	err = h.ctrl.DeleteLastProduct(r.Context(), pvzID)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrNoItems) {
 			utils.ErrResponse(w, http.StatusBadRequest, err)
 			return
@@ -226,6 +476,83 @@ func (h *Handler) deleteLastProduct(w http.ResponseWriter, r *http.Request) {
 	utils.StatusResponse(w, http.StatusOK)
 }
 
+func (h *Handler) undoLastDelete(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 4 {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	pvzID, err := uuid.Parse(parts[2])
+	if err != nil || pvzID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.UndoLastDelete(r.Context(), pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrNoDeletedItems) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrBarcodeAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) deleteProduct(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 5 {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	receptionID, err := uuid.Parse(parts[2])
+	if err != nil || receptionID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+		return
+	}
+
+	productID, err := uuid.Parse(parts[4])
+	if err != nil || productID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[4]), zap.Error(err))
+		utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+		return
+	}
+
+	err = h.ctrl.DeleteProduct(r.Context(), receptionID, productID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionNotFound) || errors.Is(err, ctrl.ErrProductNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
 func (h *Handler) createReception(w http.ResponseWriter, r *http.Request) {
 	req := &dto.ReceptionsPostReq{}
 	if err := utils.Parse(r, req); err != nil {
@@ -240,10 +567,22 @@ func (h *Handler) createReception(w http.ResponseWriter, r *http.Request) {
 
 	res, err := h.ctrl.CreateReception(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
 		if errors.Is(err, ctrl.ErrReceptionStillOpen) {
 			utils.ErrResponse(w, http.StatusBadRequest, err)
 			return
 		}
+		if errors.Is(err, ctrl.ErrPVZNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrPVZClosed) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
@@ -251,27 +590,670 @@ func (h *Handler) createReception(w http.ResponseWriter, r *http.Request) {
 	utils.SuccessResponse(w, http.StatusCreated, res)
 }
 
-func (h *Handler) addItemToReception(w http.ResponseWriter, r *http.Request) {
-	req := &dto.ProductsPostReq{}
-	if err := utils.Parse(r, req); err != nil {
+func (h *Handler) reopenReception(w http.ResponseWriter, r *http.Request) {
+	receptionID, err := parseReceptionPath(r.URL.Path)
+	if err != nil {
 		utils.ErrResponse(w, http.StatusBadRequest, err)
 		return
 	}
 
-	if err := req.Validate(); err != nil {
+	req := &dto.ReceptionsReceptionIdReopenPostReq{}
+	if err = utils.Parse(r, req); err != nil {
 		utils.ErrResponse(w, http.StatusBadRequest, err)
 		return
 	}
 
-	res, err := h.ctrl.AddItemToReception(r.Context(), req)
+	if err = req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.ReopenReception(r.Context(), receptionID, req.Reason)
 	if err != nil {
-		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrTypeIsNotValid) {
+		if errors.Is(err, ctrl.ErrReceptionNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionStillOpen) {
 			utils.ErrResponse(w, http.StatusBadRequest, err)
 			return
 		}
+		if errors.Is(err, ctrl.ErrNewerReceptionExists) || errors.Is(err, ctrl.ErrReopenWindowExpired) || errors.Is(err, ctrl.ErrPVZClosed) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
 		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
 		return
 	}
 
-	utils.SuccessResponse(w, http.StatusCreated, res)
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) getReceptionSummary(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 4 {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	receptionID, err := uuid.Parse(parts[2])
+	if err != nil || receptionID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.GetReceptionSummary(r.Context(), receptionID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrReceptionNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) getManifest(w http.ResponseWriter, r *http.Request) {
+	receptionID, err := parseReceptionPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.GetManifest(r.Context(), receptionID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionNotFound) || errors.Is(err, ctrl.ErrManifestNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+// uploadManifest accepts the manifest either as JSON or, with the text/csv content type, as CSV.
+func (h *Handler) uploadManifest(w http.ResponseWriter, r *http.Request) {
+	receptionID, err := parseReceptionPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var items []dto.ManifestItem
+	if ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); ct == "text/csv" {
+		if items, err = parseManifestCSV(r.Body); err != nil {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+	} else {
+		req := &dto.ManifestUpload{}
+		if err = utils.Parse(r, req); err != nil {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err = req.Validate(); err != nil {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		items = req.Items
+	}
+
+	strict, _ := strconv.ParseBool(r.URL.Query().Get("strict"))
+	res, err := h.ctrl.UploadManifest(r.Context(), receptionID, strict, items)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrInvalidManifestSize) || errors.Is(err, ctrl.ErrManifestItemIsNotValid) || errors.Is(err, ctrl.ErrManifestBarcodeConflict) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrBarcodeIsNotValid) || errors.Is(err, ctrl.ErrQuantityIsNotValid) || errors.Is(err, ctrl.ErrTypeIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionAlreadyClosed) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) getDiscrepancies(w http.ResponseWriter, r *http.Request) {
+	receptionID, err := parseReceptionPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.GetDiscrepancies(r.Context(), receptionID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrReceptionNotFound) || errors.Is(err, ctrl.ErrManifestNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) addItemToReception(w http.ResponseWriter, r *http.Request) {
+	req := &dto.ProductsPostReq{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.AddItemToReception(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrTypeIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrBarcodeIsNotValid) || errors.Is(err, ctrl.ErrQuantityIsNotValid) || errors.Is(err, ctrl.ErrWeightIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrBarcodeAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+func (h *Handler) findProducts(w http.ResponseWriter, r *http.Request) {
+	barcode := r.URL.Query().Get("barcode")
+	if barcode == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrBarcodeIsMissing)
+		return
+	}
+
+	res, err := h.ctrl.FindProductsByBarcode(r.Context(), barcode)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrBarcodeIsNotValid) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) addItemsToReception(w http.ResponseWriter, r *http.Request) {
+	req := &dto.ProductsBatchPostReq{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.AddItemsToReception(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrPVZForbidden) {
+			utils.ErrResponse(w, http.StatusForbidden, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrNoActiveReception) || errors.Is(err, ctrl.ErrInvalidBatchSize) {
+			utils.ErrResponse(w, http.StatusBadRequest, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) listCities(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ctrl.ListCities(r.Context())
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) createCity(w http.ResponseWriter, r *http.Request) {
+	req := &dto.DictionaryEntry{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateCity(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCityAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+func (h *Handler) setCityActive(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	req := &dto.DictionaryEntryUpdate{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.SetCityActive(r.Context(), parts[2], req.IsActive)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCityNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) deleteCity(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	err := h.ctrl.DeleteCity(r.Context(), parts[2])
+	if err != nil {
+		if errors.Is(err, ctrl.ErrCityNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrCityInUse) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+func (h *Handler) listProductTypes(w http.ResponseWriter, r *http.Request) {
+	res, err := h.ctrl.ListProductTypes(r.Context())
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) createProductType(w http.ResponseWriter, r *http.Request) {
+	req := &dto.DictionaryEntry{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.CreateProductType(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrTypeAlreadyExists) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusCreated, res)
+}
+
+func (h *Handler) setProductTypeActive(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	req := &dto.DictionaryEntryUpdate{}
+	if err := utils.Parse(r, req); err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.SetProductTypeActive(r.Context(), parts[2], req.IsActive)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrTypeNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) deleteProductType(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	err := h.ctrl.DeleteProductType(r.Context(), parts[2])
+	if err != nil {
+		if errors.Is(err, ctrl.ErrTypeNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, ctrl.ErrTypeInUse) {
+			utils.ErrResponse(w, http.StatusConflict, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+func (h *Handler) listAssignments(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) != 4 {
+		utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidPathSegments)
+		return
+	}
+
+	uid, err := uuid.Parse(parts[2])
+	if err != nil || uid == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+		return
+	}
+
+	res, err := h.ctrl.ListAssignments(r.Context(), uid)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) assignPVZ(w http.ResponseWriter, r *http.Request) {
+	uid, pvzID, err := parseAssignmentPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.ctrl.AssignPVZ(r.Context(), uid, pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrEmployeeOrPVZNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
+}
+
+func (h *Handler) unassignPVZ(w http.ResponseWriter, r *http.Request) {
+	uid, pvzID, err := parseAssignmentPath(r.URL.Path)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = h.ctrl.UnassignPVZ(r.Context(), uid, pvzID)
+	if err != nil {
+		if errors.Is(err, ctrl.ErrAssignmentNotFound) {
+			utils.ErrResponse(w, http.StatusNotFound, err)
+			return
+		}
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.StatusResponse(w, http.StatusOK)
+}
+
+// parseAssignmentPath extracts ids from /users/{userId}/pvz/{pvzId}.
+func parseAssignmentPath(path string) (uuid.UUID, uuid.UUID, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 5 {
+		return uuid.Nil, uuid.Nil, ErrInvalidPathSegments
+	}
+
+	uid, err := uuid.Parse(parts[2])
+	if err != nil || uid == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		return uuid.Nil, uuid.Nil, ErrFailedToParseUUID
+	}
+
+	pvzID, err := uuid.Parse(parts[4])
+	if err != nil || pvzID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[4]), zap.Error(err))
+		return uuid.Nil, uuid.Nil, ErrFailedToParseUUID
+	}
+
+	return uid, pvzID, nil
+}
+
+// isPVZValidationErr reports whether err rejects the attributes of a PVZ sent by the client.
+func isPVZValidationErr(err error) bool {
+	return errors.Is(err, ctrl.ErrWorkingHoursIsNotValid) ||
+		errors.Is(err, ctrl.ErrTimezoneIsNotValid) ||
+		errors.Is(err, ctrl.ErrTimezoneRequired) ||
+		errors.Is(err, ctrl.ErrCoordinatesIsNotValid) ||
+		errors.Is(err, ctrl.ErrCapacityIsNotValid)
+}
+
+// parsePVZPath extracts the pvz id from /pvz/{id} and /pvz/{id}/{action}.
+func parsePVZPath(path string) (uuid.UUID, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 && len(parts) != 4 {
+		return uuid.Nil, ErrInvalidPathSegments
+	}
+
+	pvzID, err := uuid.Parse(parts[2])
+	if err != nil || pvzID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		return uuid.Nil, ErrFailedToParseUUID
+	}
+
+	return pvzID, nil
+}
+
+// parseReceptionPath extracts the reception id from /receptions/{id}/{resource}.
+func parseReceptionPath(path string) (uuid.UUID, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) != 4 {
+		return uuid.Nil, ErrInvalidPathSegments
+	}
+
+	receptionID, err := uuid.Parse(parts[2])
+	if err != nil || receptionID == uuid.Nil {
+		zap.L().Debug("Failed to parse uuid", zap.String("uuid", parts[2]), zap.Error(err))
+		return uuid.Nil, ErrFailedToParseUUID
+	}
+
+	return receptionID, nil
+}
+
+// parseManifestCSV reads manifest items from CSV with a header row. The quantity column is required,
+// type and barcode columns are optional and empty cells are treated as not set.
+func parseManifestCSV(body io.Reader) ([]dto.ManifestItem, error) {
+	rd := csv.NewReader(body)
+	rd.TrimLeadingSpace = true
+
+	header, err := rd.Read()
+	if err != nil {
+		zap.L().Debug("Failed to read manifest csv header", zap.Error(err))
+		return nil, ErrInvalidManifestCSV
+	}
+
+	cols := map[string]int{"type": -1, "barcode": -1, "quantity": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := cols[name]; ok {
+			cols[name] = i
+		}
+	}
+	if cols["quantity"] < 0 || (cols["type"] < 0 && cols["barcode"] < 0) {
+		return nil, ErrInvalidManifestCSV
+	}
+
+	items := make([]dto.ManifestItem, 0)
+	for {
+		rec, err := rd.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			zap.L().Debug("Failed to read manifest csv", zap.Error(err))
+			return nil, ErrInvalidManifestCSV
+		}
+
+		if len(items) == ctrl.MaxManifestItems {
+			return nil, ctrl.ErrInvalidManifestSize
+		}
+
+		quantity, err := strconv.Atoi(strings.TrimSpace(rec[cols["quantity"]]))
+		if err != nil {
+			zap.L().Debug("Invalid manifest quantity", zap.String("quantity", rec[cols["quantity"]]), zap.Error(err))
+			return nil, ErrInvalidManifestCSV
+		}
+
+		item := dto.ManifestItem{Quantity: quantity}
+		if i := cols["type"]; i >= 0 && strings.TrimSpace(rec[i]) != "" {
+			item.Type = dto.NewOptString(strings.TrimSpace(rec[i]))
+		}
+		if i := cols["barcode"]; i >= 0 && strings.TrimSpace(rec[i]) != "" {
+			item.Barcode = dto.NewOptString(strings.TrimSpace(rec[i]))
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (h *Handler) listAudit(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := &md.AuditFilter{Action: q.Get("action")}
+
+	var err error
+	if v := q.Get("pvzId"); v != "" {
+		if filter.PVZID, err = uuid.Parse(v); err != nil {
+			zap.L().Debug("Failed to parse uuid", zap.String("uuid", v), zap.Error(err))
+			utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+			return
+		}
+	}
+
+	if v := q.Get("actorId"); v != "" {
+		if filter.ActorID, err = uuid.Parse(v); err != nil {
+			zap.L().Debug("Failed to parse uuid", zap.String("uuid", v), zap.Error(err))
+			utils.ErrResponse(w, http.StatusBadRequest, ErrFailedToParseUUID)
+			return
+		}
+	}
+
+	if v := q.Get("startDate"); v != "" {
+		if filter.StartDate, err = time.Parse(time.RFC3339, v); err != nil {
+			zap.L().Debug("Invalid date format", zap.String("date", v), zap.Error(err))
+			utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidDate)
+			return
+		}
+	}
+
+	if v := q.Get("endDate"); v != "" {
+		if filter.EndDate, err = time.Parse(time.RFC3339, v); err != nil {
+			zap.L().Debug("Invalid date format", zap.String("date", v), zap.Error(err))
+			utils.ErrResponse(w, http.StatusBadRequest, ErrInvalidDate)
+			return
+		}
+	}
+
+	filter.Page, err = strconv.ParseInt(q.Get("page"), 10, 64)
+	if err != nil || filter.Page < 1 {
+		filter.Page = 1
+	}
+
+	filter.Limit, err = strconv.ParseInt(q.Get("limit"), 10, 64)
+	if err != nil || filter.Limit < 1 {
+		filter.Limit = 50
+	}
+	filter.Limit = min(filter.Limit, 100)
+
+	res, err := h.ctrl.ListAudit(r.Context(), filter)
+	if err != nil {
+		utils.ErrResponse(w, http.StatusInternalServerError, hdl.ErrInternal)
+		return
+	}
+
+	utils.SuccessResponse(w, http.StatusOK, res)
 }
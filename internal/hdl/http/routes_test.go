@@ -2,6 +2,7 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,7 +10,9 @@ import (
 	"github.com/JMURv/avito-spring/internal/ctrl"
 	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	"github.com/JMURv/avito-spring/internal/hdl"
+	mid "github.com/JMURv/avito-spring/internal/hdl/http/middleware"
 	"github.com/JMURv/avito-spring/internal/hdl/http/utils"
+	md "github.com/JMURv/avito-spring/internal/models"
 	"github.com/JMURv/avito-spring/tests/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +21,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -303,7 +307,7 @@ func TestHandler_Login(t *testing.T) {
 				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token(""), testErr)
+				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token(""), "", testErr)
 			},
 		},
 		{
@@ -321,7 +325,7 @@ func TestHandler_Login(t *testing.T) {
 				assert.Equal(t, auth.ErrInvalidCredentials.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token(""), auth.ErrInvalidCredentials)
+				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token(""), "", auth.ErrInvalidCredentials)
 			},
 		},
 		{
@@ -332,9 +336,13 @@ func TestHandler_Login(t *testing.T) {
 				"email":    "test@example.com",
 				"password": "password",
 			},
-			assertions: func(r io.ReadCloser) {},
+			assertions: func(r io.ReadCloser) {
+				b, err := io.ReadAll(r)
+				assert.Nil(t, err)
+				assert.Equal(t, "token", string(b))
+			},
 			expect: func() {
-				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token("token"), nil)
+				mctrl.EXPECT().Login(gomock.Any(), gomock.Any()).Return(dto.Token("token"), "refresh", nil)
 			},
 		},
 	}
@@ -350,6 +358,9 @@ func TestHandler_Login(t *testing.T) {
 				w := httptest.NewRecorder()
 				h.login(w, req)
 				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.status == http.StatusOK {
+					assert.Equal(t, "refresh", w.Result().Header.Get("X-Refresh-Token"))
+				}
 
 				defer w.Result().Body.Close()
 				tt.assertions(w.Result().Body)
@@ -378,6 +389,7 @@ func TestHandler_getPVZ(t *testing.T) {
 		method      string
 		status      int
 		queryParams map[string]string
+		nextCursor  string
 		expect      func()
 		assertions  func(r io.ReadCloser)
 	}{
@@ -393,8 +405,8 @@ func TestHandler_getPVZ(t *testing.T) {
 			},
 			expect: func() {
 				mctrl.EXPECT().
-					GetPVZ(gomock.Any(), int64(2), int64(5), defaultStart, defaultEnd).
-					Return(nil, testErr)
+					GetPVZ(gomock.Any(), int64(2), int64(5), "", defaultStart, defaultEnd, false).
+					Return(nil, "", testErr)
 			},
 			assertions: func(r io.ReadCloser) {
 				defer r.Close()
@@ -409,15 +421,16 @@ func TestHandler_getPVZ(t *testing.T) {
 			method: http.MethodGet,
 			status: http.StatusOK,
 			queryParams: map[string]string{
-				"page":      "3",
-				"limit":     "10",
-				"startDate": startStr,
-				"endDate":   endStr,
+				"page":           "3",
+				"limit":          "10",
+				"startDate":      startStr,
+				"endDate":        endStr,
+				"includeDeleted": "true",
 			},
 			expect: func() {
 				mctrl.EXPECT().
-					GetPVZ(gomock.Any(), int64(3), int64(10), defaultStart, defaultEnd).
-					Return(sampleResponse, nil)
+					GetPVZ(gomock.Any(), int64(3), int64(10), "", defaultStart, defaultEnd, true).
+					Return(sampleResponse, "", nil)
 			},
 			assertions: func(r io.ReadCloser) {
 				defer r.Close()
@@ -427,6 +440,48 @@ func TestHandler_getPVZ(t *testing.T) {
 				assert.Len(t, res, len(sampleResponse))
 			},
 		},
+		{
+			name:   "Invalid cursor",
+			method: http.MethodGet,
+			status: http.StatusBadRequest,
+			queryParams: map[string]string{
+				"cursor":    "bad",
+				"startDate": startStr,
+				"endDate":   endStr,
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					GetPVZ(gomock.Any(), int64(1), int64(10), "bad", defaultStart, defaultEnd, false).
+					Return(nil, "", ctrl.ErrInvalidCursor)
+			},
+			assertions: func(r io.ReadCloser) {
+				defer r.Close()
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrInvalidCursor.Error(), res.Message)
+			},
+		},
+		{
+			name:   "Next cursor header",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			queryParams: map[string]string{
+				"cursor":    "abc",
+				"limit":     "1",
+				"startDate": startStr,
+				"endDate":   endStr,
+			},
+			nextCursor: "def",
+			expect: func() {
+				mctrl.EXPECT().
+					GetPVZ(gomock.Any(), int64(1), int64(1), "abc", defaultStart, defaultEnd, false).
+					Return(sampleResponse, "def", nil)
+			},
+			assertions: func(r io.ReadCloser) {
+				defer r.Close()
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -443,6 +498,7 @@ func TestHandler_getPVZ(t *testing.T) {
 				w := httptest.NewRecorder()
 				h.getPVZ(w, req)
 				assert.Equal(t, tt.status, w.Result().StatusCode)
+				assert.Equal(t, tt.nextCursor, w.Result().Header.Get("X-Next-Cursor"))
 
 				tt.assertions(w.Result().Body)
 			},
@@ -498,6 +554,57 @@ func TestHandler_CreatePVZ(t *testing.T) {
 			},
 			expect: func() {},
 		},
+		{
+			name:   "ErrCityIsNotValid",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"city": "Новосибирск",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrCityIsNotValid.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreatePVZ(gomock.Any(), &dto.PVZ{City: "Новосибирск"}).Return(nil, ctrl.ErrCityIsNotValid)
+			},
+		},
+		{
+			name:   "CoordinatesOutOfRange",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"city":        "Москва",
+				"coordinates": map[string]any{"latitude": 95, "longitude": 37.6},
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Contains(t, res.Message, "invalid")
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrTimezoneRequired",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"city":         "Москва",
+				"workingHours": []map[string]any{{"day": "mon", "open": "09:00", "close": "21:00"}},
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrTimezoneRequired.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreatePVZ(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrTimezoneRequired)
+			},
+		},
 		{
 			name:   "InternalError",
 			method: http.MethodPost,
@@ -612,6 +719,23 @@ func TestHandler_CloseLastReception(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:   "ErrManifestDiscrepancies",
+			url:    fmt.Sprintf("/pvz/%s/close_last_reception", uuid.New().String()),
+			status: http.StatusConflict,
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Contains(t, res.Message, ctrl.ErrManifestDiscrepancies.Error())
+			},
+			expect: func() {
+				mctrl.EXPECT().CloseLastReception(gomock.Any(), gomock.Any()).Return(
+					nil,
+					fmt.Errorf("%w: 1 missing, 0 surplus, 0 unexpected", ctrl.ErrManifestDiscrepancies),
+				)
+			},
+		},
 		{
 			name:   "InternalError",
 			url:    fmt.Sprintf("/pvz/%s/close_last_reception", uuid.New().String()),
@@ -766,78 +890,67 @@ func TestHandler_DeleteLastProduct(t *testing.T) {
 	}
 }
 
-func TestHandler_CreateReception(t *testing.T) {
-	const uri = "/receptions"
+func TestHandler_UndoLastDelete(t *testing.T) {
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
 	mctrl := mocks.NewMockAppCtrl(mock)
-	auth := mocks.NewMockCore(mock)
-	h := New(mctrl, auth)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
 
+	pvzID := uuid.New()
 	testErr := errors.New("test-err")
 	tests := []struct {
 		name       string
-		method     string
+		url        string
 		status     int
-		payload    map[string]any
 		expect     func()
 		assertions func(r io.ReadCloser)
 	}{
 		{
-			name:   "ErrDecodeRequest",
-			method: http.MethodPost,
+			name:   "ErrFailedToParseUUID",
+			url:    fmt.Sprintf("/pvz/%s/undo_last_delete", "wrong"),
 			status: http.StatusBadRequest,
-			payload: map[string]any{
-				"pvzId": 123,
-			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Equal(t, hdl.ErrDecodeRequest.Error(), res.Message)
+				assert.Equal(t, ErrFailedToParseUUID.Error(), res.Message)
 			},
 			expect: func() {},
 		},
 		{
-			name:   "ValidationError",
-			method: http.MethodPost,
-			status: http.StatusBadRequest,
-			payload: map[string]any{
-				"wrong": "",
-			},
+			name:   "ErrPVZForbidden",
+			url:    fmt.Sprintf("/pvz/%s/undo_last_delete", pvzID),
+			status: http.StatusForbidden,
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Contains(t, res.Message, "decode request")
+				assert.Equal(t, ctrl.ErrPVZForbidden.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().UndoLastDelete(gomock.Any(), pvzID).Return(nil, ctrl.ErrPVZForbidden)
 			},
-			expect: func() {},
 		},
 		{
-			name:   "ErrReceptionStillOpen",
-			method: http.MethodPost,
+			name:   "ErrNoDeletedItems",
+			url:    fmt.Sprintf("/pvz/%s/undo_last_delete", pvzID),
 			status: http.StatusBadRequest,
-			payload: map[string]any{
-				"pvzId": uuid.New().String(),
-			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Equal(t, ctrl.ErrReceptionStillOpen.Error(), res.Message)
+				assert.Equal(t, ctrl.ErrNoDeletedItems.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrReceptionStillOpen)
+				mctrl.EXPECT().UndoLastDelete(gomock.Any(), pvzID).Return(nil, ctrl.ErrNoDeletedItems)
 			},
 		},
 		{
 			name:   "InternalError",
-			method: http.MethodPost,
+			url:    fmt.Sprintf("/pvz/%s/undo_last_delete", pvzID),
 			status: http.StatusInternalServerError,
-			payload: map[string]any{
-				"pvzId": uuid.New().String(),
-			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
@@ -845,25 +958,24 @@ func TestHandler_CreateReception(t *testing.T) {
 				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, testErr)
+				mctrl.EXPECT().UndoLastDelete(gomock.Any(), pvzID).Return(nil, testErr)
 			},
 		},
 		{
 			name:   "Success",
-			method: http.MethodPost,
-			status: http.StatusCreated,
-			payload: map[string]any{
-				"pvzId":    uuid.New().String(),
-				"password": "password",
-			},
+			url:    fmt.Sprintf("/pvz/%s/undo_last_delete", pvzID),
+			status: http.StatusOK,
 			assertions: func(r io.ReadCloser) {
-				res := &dto.Reception{}
+				res := &dto.Product{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.NotNil(t, res)
+				assert.Equal(t, "обувь", res.Type)
+				assert.False(t, res.DeletedAt.Set)
 			},
 			expect: func() {
-				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(&dto.Reception{}, nil)
+				mctrl.EXPECT().
+					UndoLastDelete(gomock.Any(), pvzID).
+					Return(&dto.Product{ID: dto.NewOptUUID(uuid.New()), Type: "обувь", ReceptionId: uuid.New()}, nil)
 			},
 		},
 	}
@@ -872,12 +984,10 @@ func TestHandler_CreateReception(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.expect()
-				b, _ := json.Marshal(tt.payload)
-				req := httptest.NewRequest(tt.method, uri, bytes.NewBuffer(b))
-				req.Header.Set("Content-Type", "application/json")
+				req := httptest.NewRequest(http.MethodPost, tt.url, nil)
 
 				w := httptest.NewRecorder()
-				h.createReception(w, req)
+				h.undoLastDelete(w, req)
 				assert.Equal(t, tt.status, w.Result().StatusCode)
 
 				defer w.Result().Body.Close()
@@ -887,8 +997,108 @@ func TestHandler_CreateReception(t *testing.T) {
 	}
 }
 
-func TestHandler_AddItemToReception(t *testing.T) {
-	const uri = "/products"
+func TestHandler_DeleteProduct(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	receptionID := uuid.New()
+	productID := uuid.New()
+	uri := fmt.Sprintf("/receptions/%s/products/%s", receptionID, productID)
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		url    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrInvalidPathSegments",
+			url:    fmt.Sprintf("/receptions/%s/products", receptionID),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrFailedToParseReceptionUUID",
+			url:    fmt.Sprintf("/receptions/%s/products/%s", "wrong", productID),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrFailedToParseProductUUID",
+			url:    fmt.Sprintf("/receptions/%s/products/%s", receptionID, "wrong"),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrPVZForbidden",
+			url:    uri,
+			status: http.StatusForbidden,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(ctrl.ErrPVZForbidden)
+			},
+		},
+		{
+			name:   "ErrReceptionNotFound",
+			url:    uri,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(ctrl.ErrReceptionNotFound)
+			},
+		},
+		{
+			name:   "ErrProductNotFound",
+			url:    uri,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(ctrl.ErrProductNotFound)
+			},
+		},
+		{
+			name:   "ErrNoActiveReception",
+			url:    uri,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(ctrl.ErrNoActiveReception)
+			},
+		},
+		{
+			name:   "InternalError",
+			url:    uri,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(testErr)
+			},
+		},
+		{
+			name:   "Success",
+			url:    uri,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().DeleteProduct(gomock.Any(), receptionID, productID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodDelete, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.deleteProduct(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateReception(t *testing.T) {
+	const uri = "/receptions"
 	mock := gomock.NewController(t)
 	defer mock.Finish()
 
@@ -910,7 +1120,6 @@ func TestHandler_AddItemToReception(t *testing.T) {
 			method: http.MethodPost,
 			status: http.StatusBadRequest,
 			payload: map[string]any{
-				"type":  "type",
 				"pvzId": 123,
 			},
 			assertions: func(r io.ReadCloser) {
@@ -927,7 +1136,6 @@ func TestHandler_AddItemToReception(t *testing.T) {
 			status: http.StatusBadRequest,
 			payload: map[string]any{
 				"wrong": "",
-				"type":  "type",
 			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
@@ -938,88 +1146,281 @@ func TestHandler_AddItemToReception(t *testing.T) {
 			expect: func() {},
 		},
 		{
-			name:   "ErrNoActiveReception",
+			name:   "ErrReceptionStillOpen",
 			method: http.MethodPost,
 			status: http.StatusBadRequest,
 			payload: map[string]any{
 				"pvzId": uuid.New().String(),
-				"type":  "электроника",
 			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Equal(t, ctrl.ErrNoActiveReception.Error(), res.Message)
+				assert.Equal(t, ctrl.ErrReceptionStillOpen.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrNoActiveReception)
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrReceptionStillOpen)
 			},
 		},
 		{
-			name:   "ErrTypeIsNotValid",
+			name:   "ErrPVZNotFound",
 			method: http.MethodPost,
-			status: http.StatusBadRequest,
+			status: http.StatusNotFound,
 			payload: map[string]any{
 				"pvzId": uuid.New().String(),
-				"type":  "электроника",
 			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Equal(t, ctrl.ErrTypeIsNotValid.Error(), res.Message)
+				assert.Equal(t, ctrl.ErrPVZNotFound.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrTypeIsNotValid)
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrPVZNotFound)
 			},
 		},
 		{
-			name:   "InternalError",
+			name:   "ErrPVZClosed",
 			method: http.MethodPost,
-			status: http.StatusInternalServerError,
+			status: http.StatusConflict,
 			payload: map[string]any{
 				"pvzId": uuid.New().String(),
-				"type":  "электроника",
 			},
 			assertions: func(r io.ReadCloser) {
 				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
+				assert.Equal(t, ctrl.ErrPVZClosed.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, testErr)
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrPVZClosed)
 			},
 		},
 		{
-			name:   "Success",
+			name:   "ErrPVZForbidden",
 			method: http.MethodPost,
-			status: http.StatusCreated,
+			status: http.StatusForbidden,
 			payload: map[string]any{
-				"pvzId":    uuid.New().String(),
-				"type":     "электроника",
-				"password": "password",
+				"pvzId": uuid.New().String(),
 			},
 			assertions: func(r io.ReadCloser) {
-				res := &dto.Product{}
+				res := &utils.ErrorResponse{}
 				err := json.NewDecoder(r).Decode(res)
 				assert.Nil(t, err)
-				assert.NotNil(t, res)
+				assert.Equal(t, ctrl.ErrPVZForbidden.Error(), res.Message)
 			},
 			expect: func() {
-				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(
-					&dto.Product{},
-					nil,
-				)
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrPVZForbidden)
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(
-			tt.name, func(t *testing.T) {
-				tt.expect()
-				b, _ := json.Marshal(tt.payload)
+		{
+			name:   "InternalError",
+			method: http.MethodPost,
+			status: http.StatusInternalServerError,
+			payload: map[string]any{
+				"pvzId": uuid.New().String(),
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			status: http.StatusCreated,
+			payload: map[string]any{
+				"pvzId":    uuid.New().String(),
+				"password": "password",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &dto.Reception{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.NotNil(t, res)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreateReception(gomock.Any(), gomock.Any()).Return(&dto.Reception{}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, _ := json.Marshal(tt.payload)
+				req := httptest.NewRequest(tt.method, uri, bytes.NewBuffer(b))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.createReception(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+
+				defer w.Result().Body.Close()
+				tt.assertions(w.Result().Body)
+			},
+		)
+	}
+}
+
+func TestHandler_AddItemToReception(t *testing.T) {
+	const uri = "/products"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	auth := mocks.NewMockCore(mock)
+	h := New(mctrl, auth)
+
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name       string
+		method     string
+		status     int
+		payload    map[string]any
+		expect     func()
+		assertions func(r io.ReadCloser)
+	}{
+		{
+			name:   "ErrDecodeRequest",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"type":  "type",
+				"pvzId": 123,
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, hdl.ErrDecodeRequest.Error(), res.Message)
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ValidationError",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"wrong": "",
+				"type":  "type",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Contains(t, res.Message, "decode request")
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrNoActiveReception",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"pvzId": uuid.New().String(),
+				"type":  "электроника",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrNoActiveReception.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrNoActiveReception)
+			},
+		},
+		{
+			name:   "ErrTypeIsNotValid",
+			method: http.MethodPost,
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"pvzId": uuid.New().String(),
+				"type":  "электроника",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrTypeIsNotValid.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrTypeIsNotValid)
+			},
+		},
+		{
+			name:   "ErrBarcodeAlreadyExists",
+			method: http.MethodPost,
+			status: http.StatusConflict,
+			payload: map[string]any{
+				"pvzId":   uuid.New().String(),
+				"type":    "электроника",
+				"barcode": "4006381333931",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrBarcodeAlreadyExists.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrBarcodeAlreadyExists)
+			},
+		},
+		{
+			name:   "InternalError",
+			method: http.MethodPost,
+			status: http.StatusInternalServerError,
+			payload: map[string]any{
+				"pvzId": uuid.New().String(),
+				"type":  "электроника",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			status: http.StatusCreated,
+			payload: map[string]any{
+				"pvzId":    uuid.New().String(),
+				"type":     "электроника",
+				"password": "password",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &dto.Product{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.NotNil(t, res)
+			},
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(
+					&dto.Product{},
+					nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, _ := json.Marshal(tt.payload)
 				req := httptest.NewRequest(tt.method, uri, bytes.NewBuffer(b))
 				req.Header.Set("Content-Type", "application/json")
 
@@ -1033,3 +1434,1658 @@ func TestHandler_AddItemToReception(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_GetReceptionSummary(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	testErr := errors.New("test-err")
+	receptionID := uuid.New()
+	tests := []struct {
+		name       string
+		url        string
+		status     int
+		expect     func()
+		assertions func(r io.ReadCloser)
+	}{
+		{
+			name:   "ErrInvalidPathSegments",
+			url:    fmt.Sprintf("/receptions/%s/summary", "wro/ng"),
+			status: http.StatusBadRequest,
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ErrInvalidPathSegments.Error(), res.Message)
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrFailedToParseUUID",
+			url:    fmt.Sprintf("/receptions/%s/summary", "wrong"),
+			status: http.StatusBadRequest,
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ErrFailedToParseUUID.Error(), res.Message)
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrReceptionNotFound",
+			url:    fmt.Sprintf("/receptions/%s/summary", receptionID),
+			status: http.StatusNotFound,
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrReceptionNotFound.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().GetReceptionSummary(gomock.Any(), receptionID).Return(nil, ctrl.ErrReceptionNotFound)
+			},
+		},
+		{
+			name:   "InternalError",
+			url:    fmt.Sprintf("/receptions/%s/summary", receptionID),
+			status: http.StatusInternalServerError,
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().GetReceptionSummary(gomock.Any(), receptionID).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			url:    fmt.Sprintf("/receptions/%s/summary", receptionID),
+			status: http.StatusOK,
+			assertions: func(r io.ReadCloser) {
+				res := &dto.ReceptionSummary{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, receptionID, res.ReceptionId)
+				assert.Equal(t, int64(1), res.TotalProducts)
+			},
+			expect: func() {
+				mctrl.EXPECT().GetReceptionSummary(gomock.Any(), receptionID).Return(
+					&dto.ReceptionSummary{
+						ReceptionId:   receptionID,
+						ProductCounts: []dto.ReceptionSummaryProductCountsItem{{Type: "обувь", Count: 1}},
+						TotalProducts: 1,
+						OpenedAt:      time.Now(),
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+
+				w := httptest.NewRecorder()
+				h.getReceptionSummary(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+
+				defer w.Result().Body.Close()
+				tt.assertions(w.Result().Body)
+			},
+		)
+	}
+}
+
+func TestHandler_AddItemsToReception(t *testing.T) {
+	const uri = "/products/batch"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	pvzID := uuid.New()
+	testErr := errors.New("test-err")
+	req := &dto.ProductsBatchPostReq{
+		PvzId: pvzID,
+		Items: []dto.ProductBatchItem{{Type: "обувь"}, {Type: "unknown"}},
+	}
+	tests := []struct {
+		name   string
+		body   any
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrDecodeRequest",
+			body:   map[string]any{"pvzId": 123},
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "EmptyBatch",
+			body:   &dto.ProductsBatchPostReq{PvzId: pvzID, Items: []dto.ProductBatchItem{}},
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrNoActiveReception",
+			body:   req,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().AddItemsToReception(gomock.Any(), req).Return(nil, ctrl.ErrNoActiveReception)
+			},
+		},
+		{
+			name:   "ErrPVZForbidden",
+			body:   req,
+			status: http.StatusForbidden,
+			expect: func() {
+				mctrl.EXPECT().AddItemsToReception(gomock.Any(), req).Return(nil, ctrl.ErrPVZForbidden)
+			},
+		},
+		{
+			name:   "InternalError",
+			body:   req,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().AddItemsToReception(gomock.Any(), req).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			body:   req,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					AddItemsToReception(gomock.Any(), req).
+					Return(
+						&dto.ProductBatch{
+							Added:  1,
+							Failed: 1,
+							Results: []dto.ProductBatchResult{
+								{Index: 0, Product: dto.NewOptProduct(dto.Product{Type: "обувь", ReceptionId: uuid.New()})},
+								{Index: 1, Error: dto.NewOptString(ctrl.ErrTypeIsNotValid.Error())},
+							},
+						}, nil,
+					)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				payload, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(payload))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.addItemsToReception(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_CreateCity(t *testing.T) {
+	const uri = "/cities"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name       string
+		status     int
+		payload    map[string]any
+		expect     func()
+		assertions func(r io.ReadCloser)
+	}{
+		{
+			name:   "ValidationError",
+			status: http.StatusBadRequest,
+			payload: map[string]any{
+				"name": "",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Contains(t, res.Message, "invalid")
+			},
+			expect: func() {},
+		},
+		{
+			name:   "ErrCityAlreadyExists",
+			status: http.StatusConflict,
+			payload: map[string]any{
+				"name": "Москва",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrCityAlreadyExists.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreateCity(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrCityAlreadyExists)
+			},
+		},
+		{
+			name:   "InternalError",
+			status: http.StatusInternalServerError,
+			payload: map[string]any{
+				"name": "Новосибирск",
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, hdl.ErrInternal.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().CreateCity(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			status: http.StatusCreated,
+			payload: map[string]any{
+				"name":     "Новосибирск",
+				"isActive": false,
+			},
+			assertions: func(r io.ReadCloser) {
+				res := &dto.DictionaryEntry{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, "Новосибирск", res.Name)
+				assert.False(t, res.IsActive.Value)
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					CreateCity(gomock.Any(), &dto.DictionaryEntry{Name: "Новосибирск", IsActive: dto.NewOptBool(false)}).
+					Return(&dto.DictionaryEntry{Name: "Новосибирск", IsActive: dto.NewOptBool(false)}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, err := json.Marshal(tt.payload)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(b))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.createCity(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+
+				defer w.Result().Body.Close()
+				tt.assertions(w.Result().Body)
+			},
+		)
+	}
+}
+
+func TestHandler_SetProductTypeActive(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	tests := []struct {
+		name       string
+		url        string
+		status     int
+		payload    map[string]any
+		expect     func()
+		assertions func(r io.ReadCloser)
+	}{
+		{
+			name:    "ErrInvalidPathSegments",
+			url:     "/product-types/",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"isActive": false},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ErrInvalidPathSegments.Error(), res.Message)
+			},
+			expect: func() {},
+		},
+		{
+			name:    "ErrTypeNotFound",
+			url:     "/product-types/unknown",
+			status:  http.StatusNotFound,
+			payload: map[string]any{"isActive": false},
+			assertions: func(r io.ReadCloser) {
+				res := &utils.ErrorResponse{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, ctrl.ErrTypeNotFound.Error(), res.Message)
+			},
+			expect: func() {
+				mctrl.EXPECT().SetProductTypeActive(gomock.Any(), "unknown", false).Return(nil, ctrl.ErrTypeNotFound)
+			},
+		},
+		{
+			name:    "Success",
+			url:     "/product-types/обувь",
+			status:  http.StatusOK,
+			payload: map[string]any{"isActive": false},
+			assertions: func(r io.ReadCloser) {
+				res := &dto.DictionaryEntry{}
+				err := json.NewDecoder(r).Decode(res)
+				assert.Nil(t, err)
+				assert.Equal(t, "обувь", res.Name)
+				assert.False(t, res.IsActive.Value)
+			},
+			expect: func() {
+				mctrl.EXPECT().
+					SetProductTypeActive(gomock.Any(), "обувь", false).
+					Return(&dto.DictionaryEntry{Name: "обувь", IsActive: dto.NewOptBool(false)}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, err := json.Marshal(tt.payload)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPut, tt.url, bytes.NewBuffer(b))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.setProductTypeActive(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+
+				defer w.Result().Body.Close()
+				tt.assertions(w.Result().Body)
+			},
+		)
+	}
+}
+
+func TestHandler_DeleteCity(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "ErrCityNotFound", err: ctrl.ErrCityNotFound, status: http.StatusNotFound},
+		{name: "ErrCityInUse", err: ctrl.ErrCityInUse, status: http.StatusConflict},
+		{name: "InternalError", err: testErr, status: http.StatusInternalServerError},
+		{name: "Success", err: nil, status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mctrl.EXPECT().DeleteCity(gomock.Any(), "Казань").Return(tt.err)
+				req := httptest.NewRequest(http.MethodDelete, "/cities/%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C", nil)
+
+				w := httptest.NewRecorder()
+				h.deleteCity(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_Refresh(t *testing.T) {
+	const uri = "/refresh"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name    string
+		status  int
+		payload map[string]any
+		expect  func()
+	}{
+		{
+			name:    "ValidationError",
+			status:  http.StatusBadRequest,
+			payload: map[string]any{"refreshToken": ""},
+			expect:  func() {},
+		},
+		{
+			name:    "ErrInvalidRefreshToken",
+			status:  http.StatusUnauthorized,
+			payload: map[string]any{"refreshToken": "old"},
+			expect: func() {
+				mctrl.EXPECT().Refresh(gomock.Any(), "old").Return(dto.Token(""), "", auth.ErrInvalidRefreshToken)
+			},
+		},
+		{
+			name:    "InternalError",
+			status:  http.StatusInternalServerError,
+			payload: map[string]any{"refreshToken": "old"},
+			expect: func() {
+				mctrl.EXPECT().Refresh(gomock.Any(), "old").Return(dto.Token(""), "", testErr)
+			},
+		},
+		{
+			name:    "Success",
+			status:  http.StatusOK,
+			payload: map[string]any{"refreshToken": "old"},
+			expect: func() {
+				mctrl.EXPECT().Refresh(gomock.Any(), "old").Return(dto.Token("token"), "new", nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, err := json.Marshal(tt.payload)
+				require.NoError(t, err)
+
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(b))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+				h.refresh(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.status == http.StatusOK {
+					assert.Equal(t, "new", w.Result().Header.Get("X-Refresh-Token"))
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_Logout(t *testing.T) {
+	const uri = "/logout"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		expect func()
+	}{
+		{
+			name:   "ErrDecodeRequest",
+			status: http.StatusBadRequest,
+			body:   "{",
+			expect: func() {},
+		},
+		{
+			name:   "InternalError",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().Logout(gomock.Any(), "").Return(testErr)
+			},
+		},
+		{
+			name:   "Without body",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().Logout(gomock.Any(), "").Return(nil)
+			},
+		},
+		{
+			name:   "With refresh token",
+			status: http.StatusOK,
+			body:   `{"refreshToken": "refresh"}`,
+			expect: func() {
+				mctrl.EXPECT().Logout(gomock.Any(), "refresh").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodPost, uri, strings.NewReader(tt.body))
+
+				w := httptest.NewRecorder()
+				h.logout(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_JWKS(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	au.EXPECT().JWKS().Return(
+		auth.JWKS{
+			Keys: []auth.JWK{{Kty: "OKP", Kid: "2025-05", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"}},
+		},
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	w := httptest.NewRecorder()
+	h.jwks(w, req)
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	assert.NotEmpty(t, w.Result().Header.Get("Cache-Control"))
+
+	defer w.Result().Body.Close()
+	res := &auth.JWKS{}
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(res))
+	require.Len(t, res.Keys, 1)
+	assert.Equal(t, "2025-05", res.Keys[0].Kid)
+	assert.Empty(t, res.Keys[0].N)
+}
+
+func TestHandler_AssignPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "InvalidUserID",
+			uri:    "/users/invalid/pvz/" + pvzID.String(),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InvalidPathSegments",
+			uri:    "/users/" + uid.String() + "/pvz",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ErrEmployeeOrPVZNotFound",
+			uri:    "/users/" + uid.String() + "/pvz/" + pvzID.String(),
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().AssignPVZ(gomock.Any(), uid, pvzID).Return(nil, ctrl.ErrEmployeeOrPVZNotFound)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    "/users/" + uid.String() + "/pvz/" + pvzID.String(),
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().AssignPVZ(gomock.Any(), uid, pvzID).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			uri:    "/users/" + uid.String() + "/pvz/" + pvzID.String(),
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().AssignPVZ(gomock.Any(), uid, pvzID).Return(&dto.PVZAssignment{UserId: uid, PvzId: pvzID}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodPut, tt.uri, nil)
+
+				w := httptest.NewRecorder()
+				h.assignPVZ(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_UnassignPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	uri := "/users/" + uid.String() + "/pvz/" + pvzID.String()
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "ErrAssignmentNotFound", err: ctrl.ErrAssignmentNotFound, status: http.StatusNotFound},
+		{name: "InternalError", err: testErr, status: http.StatusInternalServerError},
+		{name: "Success", err: nil, status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				mctrl.EXPECT().UnassignPVZ(gomock.Any(), uid, pvzID).Return(tt.err)
+				req := httptest.NewRequest(http.MethodDelete, uri, nil)
+
+				w := httptest.NewRecorder()
+				h.unassignPVZ(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_ListAssignments(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	uid := uuid.New()
+	mctrl.EXPECT().
+		ListAssignments(gomock.Any(), uid).
+		Return([]*dto.PVZAssignment{{UserId: uid, PvzId: uuid.New()}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/"+uid.String()+"/pvz", nil)
+	w := httptest.NewRecorder()
+	h.listAssignments(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var res []dto.PVZAssignment
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&res))
+	require.Len(t, res, 1)
+	assert.Equal(t, uid, res[0].UserId)
+}
+
+func TestHandler_ListAudit(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	pvzID := uuid.New()
+	actorID := uuid.New()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "InvalidPVZID",
+			uri:    "/audit?pvzId=wrong",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InvalidDate",
+			uri:    "/audit?startDate=yesterday",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InternalError",
+			uri:    "/audit",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ListAudit(gomock.Any(), &md.AuditFilter{Page: 1, Limit: 50}).Return(nil, testErr)
+			},
+		},
+		{
+			name: "Success",
+			uri: "/audit?pvzId=" + pvzID.String() + "&actorId=" + actorID.String() +
+				"&action=product_deleted&startDate=2025-01-01T00:00:00Z&page=2&limit=500",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					ListAudit(
+						gomock.Any(), &md.AuditFilter{
+							PVZID:     pvzID,
+							ActorID:   actorID,
+							Action:    md.AuditProductDeleted,
+							StartDate: start,
+							Page:      2,
+							Limit:     100,
+						},
+					).
+					Return([]*dto.AuditEntry{{ID: 1, Action: md.AuditProductDeleted, Before: []byte(`{"type":"обувь"}`)}}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodGet, tt.uri, nil)
+
+				w := httptest.NewRecorder()
+				h.listAudit(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_FindProducts(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	const barcode = "4006381333931"
+	testErr := errors.New("test-err")
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "MissingBarcode",
+			uri:    "/products",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InvalidBarcode",
+			uri:    "/products?barcode=4006381333932",
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().FindProductsByBarcode(gomock.Any(), "4006381333932").Return(nil, ctrl.ErrBarcodeIsNotValid)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    "/products?barcode=" + barcode,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().FindProductsByBarcode(gomock.Any(), barcode).Return(nil, testErr)
+			},
+		},
+		{
+			name:   "Success",
+			uri:    "/products?barcode=" + barcode,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().FindProductsByBarcode(gomock.Any(), barcode).Return(
+					[]*dto.Product{{Barcode: dto.NewOptString(barcode), Quantity: dto.NewOptInt(1)}}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodGet, tt.uri, nil)
+
+				w := httptest.NewRecorder()
+				h.findProducts(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_Idempotency(t *testing.T) {
+	const uri = "/products"
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	auth := mocks.NewMockCore(mock)
+	h := New(mctrl, auth)
+	hdlr := mid.Idempotency(mctrl)(http.HandlerFunc(h.addItemToReception))
+
+	testErr := errors.New("test-err")
+	payload := map[string]any{
+		"pvzId": uuid.New().String(),
+		"type":  "электроника",
+	}
+	stored := &md.IdempotencyKey{
+		Key:         "key",
+		StatusCode:  http.StatusCreated,
+		ContentType: "application/problem+json",
+		Response:    []byte(`{"type":"электроника"}`),
+		Completed:   true,
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		status   int
+		replayed bool
+		expect   func()
+	}{
+		{
+			name:   "NoKey",
+			status: http.StatusCreated,
+			expect: func() {
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(&dto.Product{}, nil)
+			},
+		},
+		{
+			name:   "KeyTooLong",
+			key:    strings.Repeat("k", 256),
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "Reused",
+			key:    "key",
+			status: http.StatusUnprocessableEntity,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrIdempotencyKeyReused)
+			},
+		},
+		{
+			name:   "InProgress",
+			key:    "key",
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, ctrl.ErrIdempotencyKeyInProgress)
+			},
+		},
+		{
+			name:   "BeginError",
+			key:    "key",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+		},
+		{
+			name:     "Replay",
+			key:      "key",
+			status:   http.StatusCreated,
+			replayed: true,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(stored, nil)
+			},
+		},
+		{
+			name:   "Complete",
+			key:    "key",
+			status: http.StatusCreated,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(&dto.Product{}, nil)
+				mctrl.EXPECT().CompleteIdempotent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, k *md.IdempotencyKey) error {
+						assert.Equal(t, http.StatusCreated, k.StatusCode)
+						assert.Equal(t, "application/json", k.ContentType)
+						assert.NotEmpty(t, k.Response)
+						return nil
+					},
+				)
+			},
+		},
+		{
+			name:   "Release",
+			key:    "key",
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mctrl.EXPECT().AddItemToReception(gomock.Any(), gomock.Any()).Return(nil, testErr)
+				mctrl.EXPECT().ReleaseIdempotent(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				b, _ := json.Marshal(payload)
+				req := httptest.NewRequest(http.MethodPost, uri, bytes.NewBuffer(b))
+				req = req.WithContext(context.WithValue(req.Context(), "uid", uuid.New()))
+				req.Header.Set("Content-Type", "application/json")
+				if tt.key != "" {
+					req.Header.Set(mid.IdempotencyKeyHeader, tt.key)
+				}
+
+				w := httptest.NewRecorder()
+				hdlr.ServeHTTP(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+				if tt.replayed {
+					assert.Equal(t, "true", w.Result().Header.Get(mid.IdempotentReplayedHeader))
+					assert.Equal(t, stored.ContentType, w.Result().Header.Get("Content-Type"))
+					assert.Equal(t, stored.Response, w.Body.Bytes())
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_IdempotencyQuery(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	hdlr := mid.Idempotency(mctrl)(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				utils.SuccessResponse(w, http.StatusOK, "OK")
+			},
+		),
+	)
+
+	// Requests differing only in the query string must not be replayed for each other.
+	hashes := make([]string, 0, 2)
+	mctrl.EXPECT().BeginIdempotent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, k *md.IdempotencyKey) (*md.IdempotencyKey, error) {
+			hashes = append(hashes, k.RequestHash)
+			return nil, nil
+		},
+	).Times(2)
+	mctrl.EXPECT().CompleteIdempotent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	for _, uri := range []string{"/reception/close?reason=damaged", "/reception/close?reason=late"} {
+		req := httptest.NewRequest(http.MethodPost, uri, nil)
+		req = req.WithContext(context.WithValue(req.Context(), "uid", uuid.New()))
+		req.Header.Set(mid.IdempotencyKeyHeader, "key")
+
+		w := httptest.NewRecorder()
+		hdlr.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	assert.Len(t, hashes, 2)
+	assert.NotEqual(t, hashes[0], hashes[1])
+}
+
+func TestHandler_IdempotencyAnonymous(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	auth := mocks.NewMockCore(mock)
+	h := New(mctrl, auth)
+	hdlr := mid.Idempotency(mctrl)(http.HandlerFunc(h.register))
+
+	// Without a uid both callers would share the same key scope, so the key must be ignored
+	// and neither of them may be served the response of the other.
+	mctrl.EXPECT().Register(gomock.Any(), gomock.Any()).Return(&dto.User{}, nil).Times(2)
+	for _, email := range []string{"first@example.com", "second@example.com"} {
+		b, _ := json.Marshal(map[string]any{"email": email, "password": "password", "role": md.EmployeeRole})
+		req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewBuffer(b))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(mid.IdempotencyKeyHeader, "key")
+
+		w := httptest.NewRecorder()
+		hdlr.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+		assert.Empty(t, w.Result().Header.Get(mid.IdempotentReplayedHeader))
+	}
+}
+
+func TestHandler_UploadManifest(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	receptionID := uuid.New()
+	uri := fmt.Sprintf("/receptions/%s/manifest", receptionID)
+	items := []dto.ManifestItem{
+		{Type: dto.NewOptString("обувь"), Quantity: 2},
+		{Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+	}
+	tests := []struct {
+		name        string
+		uri         string
+		contentType string
+		body        string
+		status      int
+		expect      func()
+	}{
+		{
+			name:        "ErrFailedToParseUUID",
+			uri:         "/receptions/wrong/manifest",
+			contentType: "application/json",
+			body:        `{"items":[{"type":"обувь","quantity":2}]}`,
+			status:      http.StatusBadRequest,
+			expect:      func() {},
+		},
+		{
+			name:        "EmptyJSON",
+			uri:         uri,
+			contentType: "application/json",
+			body:        `{"items":[]}`,
+			status:      http.StatusBadRequest,
+			expect:      func() {},
+		},
+		{
+			name:        "CSVWithoutQuantity",
+			uri:         uri,
+			contentType: "text/csv",
+			body:        "type,barcode\nобувь,\n",
+			status:      http.StatusBadRequest,
+			expect:      func() {},
+		},
+		{
+			name:        "CSVInvalidQuantity",
+			uri:         uri,
+			contentType: "text/csv",
+			body:        "type,quantity\nобувь,two\n",
+			status:      http.StatusBadRequest,
+			expect:      func() {},
+		},
+		{
+			name:        "ReceptionNotFound",
+			uri:         uri,
+			contentType: "application/json",
+			body:        `{"items":[{"type":"обувь","quantity":2},{"barcode":"4006381333931","quantity":1}]}`,
+			status:      http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().UploadManifest(gomock.Any(), receptionID, false, items).Return(nil, ctrl.ErrReceptionNotFound)
+			},
+		},
+		{
+			name:        "ReceptionAlreadyClosed",
+			uri:         uri,
+			contentType: "application/json",
+			body:        `{"items":[{"type":"обувь","quantity":2},{"barcode":"4006381333931","quantity":1}]}`,
+			status:      http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().UploadManifest(gomock.Any(), receptionID, false, items).Return(nil, ctrl.ErrReceptionAlreadyClosed)
+			},
+		},
+		{
+			name:        "JSON",
+			uri:         uri + "?strict=true",
+			contentType: "application/json",
+			body:        `{"items":[{"type":"обувь","quantity":2},{"barcode":"4006381333931","quantity":1}]}`,
+			status:      http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					UploadManifest(gomock.Any(), receptionID, true, items).
+					Return(&dto.Manifest{ReceptionId: receptionID, Strict: true, Items: items}, nil)
+			},
+		},
+		{
+			name:        "CSV",
+			uri:         uri,
+			contentType: "text/csv; charset=utf-8",
+			body:        "\ufeffType, Barcode, Quantity\nобувь,,2\n,4006381333931,1\n",
+			status:      http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					UploadManifest(gomock.Any(), receptionID, false, items).
+					Return(&dto.Manifest{ReceptionId: receptionID, Items: items}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				req := httptest.NewRequest(http.MethodPut, tt.uri, strings.NewReader(tt.body))
+				req.Header.Set("Content-Type", tt.contentType)
+
+				w := httptest.NewRecorder()
+				h.uploadManifest(w, req)
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_GetDiscrepancies(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	receptionID := uuid.New()
+	uri := fmt.Sprintf("/receptions/%s/discrepancies", receptionID)
+
+	mctrl.EXPECT().GetDiscrepancies(gomock.Any(), receptionID).Return(nil, ctrl.ErrManifestNotFound)
+	w := httptest.NewRecorder()
+	h.getDiscrepancies(w, httptest.NewRequest(http.MethodGet, uri, nil))
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+
+	mctrl.EXPECT().GetDiscrepancies(gomock.Any(), receptionID).Return(
+		&dto.DiscrepancyReport{
+			ReceptionId: receptionID,
+			Missing:     []dto.DiscrepancyLine{{Type: dto.NewOptString("обувь"), Expected: 2, Actual: 1}},
+			Surplus:     []dto.DiscrepancyLine{},
+			Unexpected:  []dto.DiscrepancyLine{},
+		}, nil,
+	)
+	w = httptest.NewRecorder()
+	h.getDiscrepancies(w, httptest.NewRequest(http.MethodGet, uri, nil))
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	res := &dto.DiscrepancyReport{}
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(res))
+	assert.Len(t, res.Missing, 1)
+	assert.False(t, res.Reconciled)
+}
+
+func TestHandler_ReopenReception(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	const reason = "products were scanned into the wrong reception"
+	receptionID := uuid.New()
+	uri := fmt.Sprintf("/receptions/%s/reopen", receptionID)
+	body := `{"reason":"` + reason + `"}`
+	tests := []struct {
+		name   string
+		uri    string
+		body   string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrFailedToParseUUID",
+			uri:    "/receptions/wrong/reopen",
+			body:   body,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "EmptyReason",
+			uri:    uri,
+			body:   `{"reason":""}`,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "ReceptionNotFound",
+			uri:    uri,
+			body:   body,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(nil, ctrl.ErrReceptionNotFound)
+			},
+		},
+		{
+			name:   "ReceptionStillOpen",
+			uri:    uri,
+			body:   body,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(nil, ctrl.ErrReceptionStillOpen)
+			},
+		},
+		{
+			name:   "NewerReceptionExists",
+			uri:    uri,
+			body:   body,
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(nil, ctrl.ErrNewerReceptionExists)
+			},
+		},
+		{
+			name:   "ReopenWindowExpired",
+			uri:    uri,
+			body:   body,
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(nil, ctrl.ErrReopenWindowExpired)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    uri,
+			body:   body,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(nil, errors.New("test error"))
+			},
+		},
+		{
+			name:   "Success",
+			uri:    uri,
+			body:   body,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().ReopenReception(gomock.Any(), receptionID, reason).Return(
+					&dto.Reception{
+						ID:           dto.NewOptUUID(receptionID),
+						PvzId:        uuid.New(),
+						Status:       dto.ReceptionStatusInProgress,
+						ReopenReason: dto.NewOptString(reason),
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				w := httptest.NewRecorder()
+				h.reopenReception(w, httptest.NewRequest(http.MethodPost, tt.uri, strings.NewReader(tt.body)))
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_GetPVZByID(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	pvzID := uuid.New()
+	uri := "/pvz/" + pvzID.String()
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrFailedToParseUUID",
+			uri:    "/pvz/wrong",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "PVZNotFound",
+			uri:    uri,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().GetPVZByID(gomock.Any(), pvzID).Return(nil, ctrl.ErrPVZNotFound)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    uri,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().GetPVZByID(gomock.Any(), pvzID).Return(nil, errors.New("test error"))
+			},
+		},
+		{
+			name:   "Success",
+			uri:    uri,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().GetPVZByID(gomock.Any(), pvzID).Return(
+					&dto.PVZ{
+						ID:     dto.NewOptUUID(pvzID),
+						City:   "Москва",
+						Status: dto.NewOptPVZStatus(dto.PVZStatusActive),
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				w := httptest.NewRecorder()
+				h.getPVZByID(w, httptest.NewRequest(http.MethodGet, tt.uri, nil))
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_UpdatePVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	pvzID := uuid.New()
+	uri := "/pvz/" + pvzID.String()
+	body := `{"name":"ПВЗ на Баумана","workingHours":[{"day":"mon","open":"09:00","close":"21:00"}]}`
+	tests := []struct {
+		name   string
+		uri    string
+		body   string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrFailedToParseUUID",
+			uri:    "/pvz/wrong",
+			body:   body,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InvalidDay",
+			uri:    uri,
+			body:   `{"workingHours":[{"day":"holiday","open":"09:00","close":"21:00"}]}`,
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "NothingToUpdate",
+			uri:    uri,
+			body:   `{}`,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, ctrl.ErrNothingToUpdate)
+			},
+		},
+		{
+			name:   "WorkingHoursNotValid",
+			uri:    uri,
+			body:   body,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, ctrl.ErrWorkingHoursIsNotValid)
+			},
+		},
+		{
+			name:   "TimezoneRequired",
+			uri:    uri,
+			body:   body,
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, ctrl.ErrTimezoneRequired)
+			},
+		},
+		{
+			name:   "PVZNotFound",
+			uri:    uri,
+			body:   body,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, ctrl.ErrPVZNotFound)
+			},
+		},
+		{
+			name:   "PVZClosed",
+			uri:    uri,
+			body:   body,
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, ctrl.ErrPVZClosed)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    uri,
+			body:   body,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(nil, errors.New("test error"))
+			},
+		},
+		{
+			name:   "Success",
+			uri:    uri,
+			body:   body,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().UpdatePVZ(gomock.Any(), pvzID, gomock.Any()).Return(
+					&dto.PVZ{
+						ID:     dto.NewOptUUID(pvzID),
+						City:   "Москва",
+						Name:   dto.NewOptString("ПВЗ на Баумана"),
+						Status: dto.NewOptPVZStatus(dto.PVZStatusActive),
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				w := httptest.NewRecorder()
+				h.updatePVZ(w, httptest.NewRequest(http.MethodPatch, tt.uri, strings.NewReader(tt.body)))
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_DecommissionPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	pvzID := uuid.New()
+	uri := fmt.Sprintf("/pvz/%s/decommission", pvzID)
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "ErrFailedToParseUUID",
+			uri:    "/pvz/wrong/decommission",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "PVZNotFound",
+			uri:    uri,
+			status: http.StatusNotFound,
+			expect: func() {
+				mctrl.EXPECT().DecommissionPVZ(gomock.Any(), pvzID).Return(nil, ctrl.ErrPVZNotFound)
+			},
+		},
+		{
+			name:   "AlreadyClosed",
+			uri:    uri,
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().DecommissionPVZ(gomock.Any(), pvzID).Return(nil, ctrl.ErrPVZClosed)
+			},
+		},
+		{
+			name:   "ReceptionStillOpen",
+			uri:    uri,
+			status: http.StatusConflict,
+			expect: func() {
+				mctrl.EXPECT().DecommissionPVZ(gomock.Any(), pvzID).Return(nil, ctrl.ErrReceptionStillOpen)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    uri,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().DecommissionPVZ(gomock.Any(), pvzID).Return(nil, errors.New("test error"))
+			},
+		},
+		{
+			name:   "Success",
+			uri:    uri,
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().DecommissionPVZ(gomock.Any(), pvzID).Return(
+					&dto.PVZ{
+						ID:     dto.NewOptUUID(pvzID),
+						City:   "Москва",
+						Status: dto.NewOptPVZStatus(dto.PVZStatusClosed),
+					}, nil,
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				w := httptest.NewRecorder()
+				h.decommissionPVZ(w, httptest.NewRequest(http.MethodPost, tt.uri, nil))
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
+
+func TestHandler_FindNearbyPVZ(t *testing.T) {
+	mock := gomock.NewController(t)
+	defer mock.Finish()
+
+	mctrl := mocks.NewMockAppCtrl(mock)
+	au := mocks.NewMockCore(mock)
+	h := New(mctrl, au)
+
+	const uri = "/pvz/nearby?lat=55.7558&lon=37.6173&radius=3000"
+	tests := []struct {
+		name   string
+		uri    string
+		status int
+		expect func()
+	}{
+		{
+			name:   "MissingRadius",
+			uri:    "/pvz/nearby?lat=55.7558&lon=37.6173",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "InvalidLatitude",
+			uri:    "/pvz/nearby?lat=north&lon=37.6173&radius=3000",
+			status: http.StatusBadRequest,
+			expect: func() {},
+		},
+		{
+			name:   "CoordinatesOutOfRange",
+			uri:    "/pvz/nearby?lat=95&lon=37.6173&radius=3000",
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 95.0, 37.6173, 3000.0, false, int64(0)).
+					Return(nil, ctrl.ErrCoordinatesIsNotValid)
+			},
+		},
+		{
+			name:   "RadiusTooLarge",
+			uri:    "/pvz/nearby?lat=55.7558&lon=37.6173&radius=100000",
+			status: http.StatusBadRequest,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 100000.0, false, int64(0)).
+					Return(nil, ctrl.ErrRadiusIsNotValid)
+			},
+		},
+		{
+			name:   "InternalError",
+			uri:    uri,
+			status: http.StatusInternalServerError,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 3000.0, false, int64(0)).
+					Return(nil, errors.New("test error"))
+			},
+		},
+		{
+			name:   "Success",
+			uri:    uri + "&openNow=true&limit=5",
+			status: http.StatusOK,
+			expect: func() {
+				mctrl.EXPECT().
+					FindNearbyPVZ(gomock.Any(), 55.7558, 37.6173, 3000.0, true, int64(5)).
+					Return(
+						[]*dto.NearbyPVZ{
+							{
+								Pvz:      dto.PVZ{ID: dto.NewOptUUID(uuid.New()), City: "Москва"},
+								Distance: 1312.4,
+							},
+						}, nil,
+					)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.expect()
+				w := httptest.NewRecorder()
+				h.findNearbyPVZ(w, httptest.NewRequest(http.MethodGet, tt.uri, nil))
+				assert.Equal(t, tt.status, w.Result().StatusCode)
+			},
+		)
+	}
+}
@@ -2,7 +2,9 @@ package mapper
 
 import (
 	gen "github.com/JMURv/avito-spring/api/grpc/v1/gen"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,9 +18,289 @@ func ListPVZsToProto(req []*md.PVZ) []*gen.PVZ {
 }
 
 func PVZToProto(pvz *md.PVZ) *gen.PVZ {
-	return &gen.PVZ{
+	res := &gen.PVZ{
 		Id:               pvz.ID.String(),
 		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
 		City:             pvz.City,
+		Name:             pvz.Name.String,
+		Address:          pvz.Address.String,
+		WorkingHours:     make([]*gen.WorkingDay, len(pvz.WorkingHours)),
+		Timezone:         pvz.Timezone.String,
+		Capacity:         pvz.Capacity.Int64,
+		Status:           PVZStatusToProto(pvz.Status),
 	}
+	for i := 0; i < len(pvz.WorkingHours); i++ {
+		res.WorkingHours[i] = &gen.WorkingDay{
+			Day:   pvz.WorkingHours[i].Day,
+			Open:  pvz.WorkingHours[i].Open,
+			Close: pvz.WorkingHours[i].Close,
+		}
+	}
+	if pvz.Latitude.Valid && pvz.Longitude.Valid {
+		res.Coordinates = &gen.Coordinates{Latitude: pvz.Latitude.Float64, Longitude: pvz.Longitude.Float64}
+	}
+	if pvz.ClosedAt.Valid {
+		res.ClosedAt = timestamppb.New(pvz.ClosedAt.Time)
+	}
+
+	return res
+}
+
+func PVZDTOToProto(pvz *dto.PVZ) *gen.PVZ {
+	res := &gen.PVZ{
+		City:         string(pvz.City),
+		Name:         pvz.Name.Value,
+		Address:      pvz.Address.Value,
+		WorkingHours: make([]*gen.WorkingDay, len(pvz.WorkingHours)),
+		Timezone:     pvz.Timezone.Value,
+		Capacity:     int64(pvz.Capacity.Value),
+		Status:       PVZStatusToProto(string(pvz.Status.Value)),
+	}
+	if pvz.ID.Set {
+		res.Id = pvz.ID.Value.String()
+	}
+	if pvz.RegistrationDate.Set {
+		res.RegistrationDate = timestamppb.New(pvz.RegistrationDate.Value)
+	}
+	for i := 0; i < len(pvz.WorkingHours); i++ {
+		res.WorkingHours[i] = &gen.WorkingDay{
+			Day:   string(pvz.WorkingHours[i].Day),
+			Open:  pvz.WorkingHours[i].Open,
+			Close: pvz.WorkingHours[i].Close,
+		}
+	}
+	if pvz.Coordinates.Set {
+		res.Coordinates = &gen.Coordinates{
+			Latitude:  pvz.Coordinates.Value.Latitude,
+			Longitude: pvz.Coordinates.Value.Longitude,
+		}
+	}
+	if pvz.ClosedAt.Set {
+		res.ClosedAt = timestamppb.New(pvz.ClosedAt.Value)
+	}
+
+	return res
+}
+
+func ListNearbyPVZToProto(req []*dto.NearbyPVZ) []*gen.NearbyPVZ {
+	res := make([]*gen.NearbyPVZ, len(req))
+	for i := 0; i < len(req); i++ {
+		res[i] = &gen.NearbyPVZ{
+			Pvz:      PVZDTOToProto(&req[i].Pvz),
+			Distance: req[i].Distance,
+		}
+	}
+
+	return res
+}
+
+// CreatePVZRequestToDTO treats empty strings, zero capacity and missing coordinates as not set.
+func CreatePVZRequestToDTO(req *gen.CreatePVZRequest) *dto.PVZ {
+	res := &dto.PVZ{City: req.City}
+	if req.Name != "" {
+		res.Name = dto.NewOptString(req.Name)
+	}
+	if req.Address != "" {
+		res.Address = dto.NewOptString(req.Address)
+	}
+	if len(req.WorkingHours) > 0 {
+		res.WorkingHours = make(dto.WorkingHours, len(req.WorkingHours))
+		for i := 0; i < len(req.WorkingHours); i++ {
+			res.WorkingHours[i] = dto.WorkingHoursItem{
+				Day:   dto.WorkingHoursItemDay(req.WorkingHours[i].Day),
+				Open:  req.WorkingHours[i].Open,
+				Close: req.WorkingHours[i].Close,
+			}
+		}
+	}
+	if req.Timezone != "" {
+		res.Timezone = dto.NewOptString(req.Timezone)
+	}
+	if req.Coordinates != nil {
+		res.Coordinates = dto.NewOptCoordinates(
+			dto.Coordinates{Latitude: req.Coordinates.Latitude, Longitude: req.Coordinates.Longitude},
+		)
+	}
+	if req.Capacity != 0 {
+		res.Capacity = dto.NewOptInt(int(req.Capacity))
+	}
+
+	return res
+}
+
+func PVZStatusToProto(status string) gen.PVZStatus {
+	if status == md.PVZStatusClosed {
+		return gen.PVZStatus_PVZ_STATUS_CLOSED
+	}
+	return gen.PVZStatus_PVZ_STATUS_ACTIVE
+}
+
+func ReceptionStatusToProto(status dto.ReceptionStatus) gen.ReceptionStatus {
+	if status == dto.ReceptionStatusInProgress {
+		return gen.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	}
+	return gen.ReceptionStatus_RECEPTION_STATUS_CLOSED
+}
+
+func ReceptionToProto(r *dto.Reception) *gen.Reception {
+	res := &gen.Reception{
+		DateTime: timestamppb.New(r.DateTime),
+		PvzId:    r.PvzId.String(),
+		Status:   ReceptionStatusToProto(r.Status),
+	}
+	if r.ID.Set {
+		res.Id = r.ID.Value.String()
+	}
+	if r.CreatedBy.Set {
+		res.CreatedBy = r.CreatedBy.Value.String()
+	}
+	if r.ClosedBy.Set {
+		res.ClosedBy = r.ClosedBy.Value.String()
+	}
+
+	return res
+}
+
+func ProductToProto(p *dto.Product) *gen.Product {
+	res := &gen.Product{
+		Type:        string(p.Type),
+		ReceptionId: p.ReceptionId.String(),
+	}
+	if p.ID.Set {
+		res.Id = p.ID.Value.String()
+	}
+	if p.DateTime.Set {
+		res.DateTime = timestamppb.New(p.DateTime.Value)
+	}
+	if p.ScannedAt.Set {
+		res.ScannedAt = timestamppb.New(p.ScannedAt.Value)
+	}
+	if p.CreatedBy.Set {
+		res.CreatedBy = p.CreatedBy.Value.String()
+	}
+	if p.DeletedAt.Set {
+		res.DeletedAt = timestamppb.New(p.DeletedAt.Value)
+	}
+	if p.DeletedBy.Set {
+		res.DeletedBy = p.DeletedBy.Value.String()
+	}
+	if p.Barcode.Set {
+		res.Barcode = p.Barcode.Value
+	}
+	if p.Sku.Set {
+		res.Sku = p.Sku.Value
+	}
+	if p.Quantity.Set {
+		res.Quantity = int64(p.Quantity.Value)
+	}
+	if p.Weight.Set {
+		res.Weight = p.Weight.Value
+	}
+
+	return res
+}
+
+func ProductBatchToProto(b *dto.ProductBatch) *gen.AddItemsToReceptionResponse {
+	res := &gen.AddItemsToReceptionResponse{
+		Added:   b.Added,
+		Failed:  b.Failed,
+		Results: make([]*gen.ProductBatchResult, len(b.Results)),
+	}
+	for i := 0; i < len(b.Results); i++ {
+		res.Results[i] = &gen.ProductBatchResult{
+			Index: int64(b.Results[i].Index),
+			Error: b.Results[i].Error.Value,
+		}
+		if b.Results[i].Product.Set {
+			res.Results[i].Product = ProductToProto(&b.Results[i].Product.Value)
+		}
+	}
+
+	return res
+}
+
+func DiscrepancyReportToProto(r *dto.DiscrepancyReport) *gen.DiscrepancyReport {
+	return &gen.DiscrepancyReport{
+		ReceptionId: r.ReceptionId.String(),
+		Strict:      r.Strict,
+		Reconciled:  r.Reconciled,
+		Missing:     discrepancyLinesToProto(r.Missing),
+		Surplus:     discrepancyLinesToProto(r.Surplus),
+		Unexpected:  discrepancyLinesToProto(r.Unexpected),
+	}
+}
+
+func discrepancyLinesToProto(lines []dto.DiscrepancyLine) []*gen.DiscrepancyLine {
+	res := make([]*gen.DiscrepancyLine, len(lines))
+	for i := 0; i < len(lines); i++ {
+		res[i] = &gen.DiscrepancyLine{
+			Type:     lines[i].Type.Value,
+			Barcode:  lines[i].Barcode.Value,
+			Expected: int64(lines[i].Expected),
+			Actual:   int64(lines[i].Actual),
+		}
+	}
+	return res
+}
+
+func ListPVZWithReceptionsToProto(req []*dto.PvzGetOKItem) []*gen.PVZWithReceptions {
+	res := make([]*gen.PVZWithReceptions, len(req))
+	for i := 0; i < len(req); i++ {
+		res[i] = PVZWithReceptionsToProto(req[i])
+	}
+
+	return res
+}
+
+func PVZWithReceptionsToProto(item *dto.PvzGetOKItem) *gen.PVZWithReceptions {
+	res := &gen.PVZWithReceptions{
+		Receptions: make([]*gen.ReceptionWithProducts, len(item.Receptions)),
+	}
+	if item.Pvz.Set {
+		res.Pvz = PVZDTOToProto(&item.Pvz.Value)
+	}
+
+	for i := 0; i < len(item.Receptions); i++ {
+		rec := &gen.ReceptionWithProducts{
+			Products: make([]*gen.Product, len(item.Receptions[i].Products)),
+		}
+		if item.Receptions[i].Reception.Set {
+			rec.Reception = ReceptionToProto(&item.Receptions[i].Reception.Value)
+		}
+		for j := 0; j < len(item.Receptions[i].Products); j++ {
+			rec.Products[j] = ProductToProto(&item.Receptions[i].Products[j])
+		}
+		res.Receptions[i] = rec
+	}
+
+	return res
+}
+
+var eventTypes = map[string]gen.PVZEventType{
+	md.EventReceptionOpened: gen.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_OPENED,
+	md.EventProductAdded:    gen.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED,
+	md.EventProductDeleted:  gen.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED,
+	md.EventReceptionClosed: gen.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED,
+	md.EventProductRestored: gen.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_RESTORED,
+}
+
+func EventToProto(e *md.Event) *gen.PVZEvent {
+	res := &gen.PVZEvent{
+		Type:        eventTypes[e.Type],
+		PvzId:       e.PVZID.String(),
+		City:        e.City,
+		ProductType: e.ProductType,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+	}
+	if e.ReceptionID != uuid.Nil {
+		res.ReceptionId = e.ReceptionID.String()
+	}
+	if e.ProductID != uuid.Nil {
+		res.ProductId = e.ProductID.String()
+	}
+	if e.UserID != uuid.Nil {
+		res.UserId = e.UserID.String()
+	}
+
+	return res
 }
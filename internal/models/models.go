@@ -1,6 +1,10 @@
 package models
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"github.com/google/uuid"
 	"time"
 )
@@ -10,6 +14,37 @@ const (
 	EmployeeRole  = "employee"
 )
 
+const (
+	EventReceptionOpened = "reception_opened"
+	EventProductAdded    = "product_added"
+	EventProductDeleted  = "product_deleted"
+	EventProductRestored = "product_restored"
+	EventReceptionClosed = "reception_closed"
+)
+
+const (
+	AuditUserRegistered      = "user_registered"
+	AuditPVZCreated          = "pvz_created"
+	AuditReceptionOpened     = "reception_opened"
+	AuditReceptionClosed     = "reception_closed"
+	AuditReceptionAutoClosed = "reception_auto_closed"
+	AuditProductAdded        = "product_added"
+	AuditProductDeleted      = "product_deleted"
+	AuditProductRestored     = "product_restored"
+	AuditCityCreated         = "city_created"
+	AuditCityUpdated         = "city_updated"
+	AuditCityDeleted         = "city_deleted"
+	AuditProductTypeCreated  = "product_type_created"
+	AuditProductTypeUpdated  = "product_type_updated"
+	AuditProductTypeDeleted  = "product_type_deleted"
+	AuditPVZAssigned         = "pvz_assigned"
+	AuditPVZUnassigned       = "pvz_unassigned"
+	AuditManifestUploaded    = "manifest_uploaded"
+	AuditReceptionReopened   = "reception_reopened"
+	AuditPVZUpdated          = "pvz_updated"
+	AuditPVZDecommissioned   = "pvz_decommissioned"
+)
+
 type User struct {
 	ID       uuid.UUID `json:"id"`
 	Email    string    `json:"email"`
@@ -17,22 +52,147 @@ type User struct {
 	Role     string    `json:"role"`
 }
 
+type RefreshToken struct {
+	UserID    uuid.UUID `json:"userId" db:"user_id"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expiresAt" db:"expires_at"`
+}
+
+// IdempotencyKey is a client supplied key together with the hash of the request it was first used with.
+// StatusCode, ContentType and Response are set once the request has completed.
+type IdempotencyKey struct {
+	UserID      uuid.UUID `db:"user_id"`
+	Key         string    `db:"key"`
+	RequestHash string    `db:"request_hash"`
+	StatusCode  int       `db:"status_code"`
+	ContentType string    `db:"content_type"`
+	Response    []byte    `db:"response"`
+	Completed   bool      `db:"completed"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+const (
+	PVZStatusActive = "active"
+	PVZStatusClosed = "closed"
+)
+
 type PVZ struct {
-	ID               uuid.UUID `json:"id"`
-	RegistrationDate time.Time `json:"registrationDate" db:"created_at"`
-	City             string    `json:"city"`
+	ID               uuid.UUID       `json:"id"`
+	RegistrationDate time.Time       `json:"registrationDate" db:"created_at"`
+	City             string          `json:"city"`
+	Name             sql.NullString  `json:"name" db:"name"`
+	Address          sql.NullString  `json:"address" db:"address"`
+	WorkingHours     WorkingHours    `json:"workingHours" db:"working_hours"`
+	Timezone         sql.NullString  `json:"timezone" db:"timezone"`
+	Latitude         sql.NullFloat64 `json:"latitude" db:"latitude"`
+	Longitude        sql.NullFloat64 `json:"longitude" db:"longitude"`
+	Capacity         sql.NullInt64   `json:"capacity" db:"capacity"`
+	Status           string          `json:"status" db:"status"`
+	ClosedAt         sql.NullTime    `json:"closedAt" db:"closed_at"`
+}
+
+// WorkingHours is the weekly schedule of a PVZ, days without an entry are days off.
+// It is stored as a JSON array, a nil schedule is stored as NULL.
+type WorkingHours []WorkingDay
+
+// WorkingDay holds the opening and closing time of a day as HH:MM in the local time of the PVZ.
+type WorkingDay struct {
+	Day   string `json:"day"`
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+func (w *WorkingHours) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*w = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, w)
+	case string:
+		return json.Unmarshal([]byte(v), w)
+	default:
+		return fmt.Errorf("cannot scan %T into WorkingHours", src)
+	}
+}
+
+func (w WorkingHours) Value() (driver.Value, error) {
+	if w == nil {
+		return nil, nil
+	}
+	return json.Marshal(w)
+}
+
+// PVZCursor is a keyset position in the PVZ listing ordered by (created_at, id).
+type PVZCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// NearbyCursor is a keyset position in the nearby search ordered by (distance, id).
+type NearbyCursor struct {
+	Distance float64
+	ID       uuid.UUID
 }
 
 type Reception struct {
-	ID       uuid.UUID `json:"id" db:"id"`
-	DateTime time.Time `json:"dateTime" db:"created_at"`
-	PVZID    uuid.UUID `json:"pvzId" db:"pickup_point_id"`
-	Status   string    `json:"status"`
+	ID        uuid.UUID     `json:"id" db:"id"`
+	DateTime  time.Time     `json:"dateTime" db:"created_at"`
+	PVZID     uuid.UUID     `json:"pvzId" db:"pickup_point_id"`
+	Status    string        `json:"status"`
+	CreatedBy uuid.NullUUID `json:"createdBy" db:"created_by"`
 }
 
 type Product struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	DateTime    time.Time `json:"dateTime" db:"created_at"`
+	ID          uuid.UUID       `json:"id" db:"id"`
+	DateTime    time.Time       `json:"dateTime" db:"created_at"`
+	ScannedAt   sql.NullTime    `json:"scannedAt" db:"scanned_at"`
+	Type        string          `json:"type"`
+	ReceptionId uuid.UUID       `json:"receptionId" db:"reception_id"`
+	CreatedBy   uuid.NullUUID   `json:"createdBy" db:"created_by"`
+	DeletedAt   sql.NullTime    `json:"deletedAt" db:"deleted_at"`
+	DeletedBy   uuid.NullUUID   `json:"deletedBy" db:"deleted_by"`
+	Barcode     sql.NullString  `json:"barcode" db:"barcode"`
+	SKU         sql.NullString  `json:"sku" db:"sku"`
+	Quantity    int             `json:"quantity" db:"quantity"`
+	Weight      sql.NullFloat64 `json:"weight" db:"weight"`
+}
+
+// ProductCount is the number of live units of a reception with the same type and barcode.
+type ProductCount struct {
+	Type     string         `db:"type"`
+	Barcode  sql.NullString `db:"barcode"`
+	Quantity int            `db:"quantity"`
+}
+
+type Event struct {
 	Type        string    `json:"type"`
-	ReceptionId uuid.UUID `json:"receptionId" db:"reception_id"`
+	PVZID       uuid.UUID `json:"pvzId"`
+	City        string    `json:"city"`
+	ReceptionID uuid.UUID `json:"receptionId"`
+	ProductID   uuid.UUID `json:"productId"`
+	ProductType string    `json:"productType"`
+	UserID      uuid.UUID `json:"userId"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// AuditEntry describes a single state change. Actor and request id are taken from the context when it is written.
+type AuditEntry struct {
+	Action      string
+	PVZID       uuid.UUID
+	ReceptionID uuid.UUID
+	ProductID   uuid.UUID
+	Before      any
+	After       any
+}
+
+// AuditFilter narrows the audit log listing, zero values are not applied.
+type AuditFilter struct {
+	PVZID     uuid.UUID
+	ActorID   uuid.UUID
+	Action    string
+	StartDate time.Time
+	EndDate   time.Time
+	Page      int64
+	Limit     int64
 }
@@ -34,6 +34,7 @@ func (m *Metric) Start(ctx context.Context) {
 		CreatedPVZ,
 		CreatedOrderReceipts,
 		AddedProducts,
+		AutoClosedReceptions,
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
@@ -109,3 +110,11 @@ var AddedProducts = promauto.NewCounter(
 		Help:      "Total number of added products",
 	},
 )
+
+var AutoClosedReceptions = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "svc",
+		Name:      "auto_closed_receptions_total",
+		Help:      "Total number of receptions closed for being idle",
+	},
+)
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+	"time"
+)
+
+// writeAudit appends e to the audit log within tx, so the entry is stored only if the change itself is committed.
+func writeAudit(ctx context.Context, tx *sqlx.Tx, e *md.AuditEntry) error {
+	before, err := auditPayload(e.Before)
+	if err != nil {
+		return err
+	}
+
+	after, err := auditPayload(e.After)
+	if err != nil {
+		return err
+	}
+
+	uid, _ := ctx.Value("uid").(uuid.UUID)
+	role, _ := ctx.Value("role").(string)
+	_, err = tx.ExecContext(
+		ctx, insertAudit,
+		nullUUID(uid),
+		nullString(role),
+		e.Action,
+		nullUUID(e.PVZID),
+		nullUUID(e.ReceptionID),
+		nullUUID(e.ProductID),
+		nullString(middleware.GetReqID(ctx)),
+		before,
+		after,
+	)
+	return err
+}
+
+func auditPayload(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (r *Repository) ListAudit(ctx context.Context, f *md.AuditFilter) ([]*dto.AuditEntry, error) {
+	rows, err := r.conn.QueryContext(
+		ctx, listAudit,
+		nullUUID(f.PVZID),
+		nullUUID(f.ActorID),
+		nullString(f.Action),
+		sql.NullTime{Time: f.StartDate, Valid: !f.StartDate.IsZero()},
+		sql.NullTime{Time: f.EndDate, Valid: !f.EndDate.IsZero()},
+		f.Limit,
+		(f.Page-1)*f.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	res := make([]*dto.AuditEntry, 0, f.Limit)
+	for rows.Next() {
+		var (
+			id          int64
+			actorID     uuid.NullUUID
+			actorRole   sql.NullString
+			action      string
+			pvzID       uuid.NullUUID
+			receptionID uuid.NullUUID
+			productID   uuid.NullUUID
+			requestID   sql.NullString
+			before      []byte
+			after       []byte
+			createdAt   time.Time
+		)
+		if err = rows.Scan(
+			&id,
+			&actorID,
+			&actorRole,
+			&action,
+			&pvzID,
+			&receptionID,
+			&productID,
+			&requestID,
+			&before,
+			&after,
+			&createdAt,
+		); err != nil {
+			return nil, err
+		}
+
+		res = append(
+			res, &dto.AuditEntry{
+				ID:          id,
+				ActorId:     optUUID(actorID),
+				ActorRole:   optString(actorRole),
+				Action:      action,
+				PvzId:       optUUID(pvzID),
+				ReceptionId: optUUID(receptionID),
+				ProductId:   optUUID(productID),
+				RequestId:   optString(requestID),
+				Before:      before,
+				After:       after,
+				CreatedAt:   createdAt,
+			},
+		)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
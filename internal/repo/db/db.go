@@ -68,37 +68,238 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*md.User
 }
 
 func (r *Repository) CreateUser(ctx context.Context, req *dto.RegisterPostReq) (uuid.UUID, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
 	var id uuid.UUID
-	err := r.conn.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx, createUser,
 		req.Email,
 		req.Password,
 		req.Role,
 	).Scan(&id)
+	if err != nil {
+		return uuid.Nil, err
+	}
 
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action: md.AuditUserRegistered,
+			After: &dto.User{
+				ID:    dto.NewOptUUID(id),
+				Email: req.Email,
+				Role:  dto.UserRole(req.Role),
+			},
+		},
+	)
 	if err != nil {
 		return uuid.Nil, err
 	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, err
+	}
 	return id, nil
 }
 
+func (r *Repository) CreateRefreshToken(ctx context.Context, uid uuid.UUID, role, hash string, expiresAt time.Time) error {
+	_, err := r.conn.ExecContext(ctx, createRefreshToken, uid, role, hash, expiresAt)
+	return err
+}
+
+// RotateRefreshToken revokes the live refresh token with the given hash and issues
+// a replacement for the same user, so every refresh token can be used only once.
+func (r *Repository) RotateRefreshToken(ctx context.Context, hash, newHash string, expiresAt time.Time) (*md.RefreshToken, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var res md.RefreshToken
+	err = tx.GetContext(ctx, &res, findRefreshTokenForUpdate, hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, revokeRefreshToken, hash, res.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, createRefreshToken, res.UserID, res.Role, newHash, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	res.ExpiresAt = expiresAt
+	return &res, nil
+}
+
+func (r *Repository) RevokeRefreshToken(ctx context.Context, hash string, uid uuid.UUID) error {
+	_, err := r.conn.ExecContext(ctx, revokeRefreshToken, hash, uid)
+	return err
+}
+
+func (r *Repository) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := r.conn.ExecContext(ctx, revokeToken, jti, expiresAt)
+	return err
+}
+
+func (r *Repository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+	if err := r.conn.GetContext(ctx, &revoked, isTokenRevoked, jti); err != nil {
+		return false, err
+	}
+	return revoked, nil
+}
+
+// ClaimIdempotencyKey stores k for a request that is about to be served. It reports false when the key is
+// already taken by an earlier request of the same user that has not expired yet.
+func (r *Repository) ClaimIdempotencyKey(ctx context.Context, k *md.IdempotencyKey) (bool, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	_, err = tx.ExecContext(ctx, deleteExpiredIdempotencyKeys, k.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tx.ExecContext(ctx, claimIdempotencyKey, k.UserID, k.Key, k.RequestHash, k.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+	return claimed > 0, nil
+}
+
+func (r *Repository) GetIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) (*md.IdempotencyKey, error) {
+	var res md.IdempotencyKey
+	err := r.conn.GetContext(ctx, &res, getIdempotencyKey, uid, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *Repository) CompleteIdempotencyKey(ctx context.Context, k *md.IdempotencyKey) error {
+	_, err := r.conn.ExecContext(ctx, completeIdempotencyKey, k.UserID, k.Key, k.StatusCode, k.Response, k.ContentType)
+	return err
+}
+
+// ReleaseIdempotencyKey forgets a key whose request has not completed, so it can be retried.
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) error {
+	_, err := r.conn.ExecContext(ctx, releaseIdempotencyKey, uid, key)
+	return err
+}
+
 func (r *Repository) CreatePVZ(ctx context.Context, req *dto.PVZ) (uuid.UUID, time.Time, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
 	var id uuid.UUID
 	var createdAt time.Time
-	err := r.conn.QueryRowContext(ctx, createPVZ, req.City).Scan(&id, &createdAt)
+	err = tx.QueryRowContext(
+		ctx, createPVZ,
+		req.City,
+		nullString(req.Name.Or("")),
+		nullString(req.Address.Or("")),
+		workingHoursFromDTO(req.WorkingHours),
+		nullString(req.Timezone.Or("")),
+		sql.NullFloat64{Float64: req.Coordinates.Value.Latitude, Valid: req.Coordinates.Set},
+		sql.NullFloat64{Float64: req.Coordinates.Value.Longitude, Valid: req.Coordinates.Set},
+		sql.NullInt64{Int64: int64(req.Capacity.Value), Valid: req.Capacity.Set},
+	).Scan(&id, &createdAt)
 	if err != nil {
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			if pgErr.Code == "22P02" {
-				return uuid.Nil, time.Time{}, repo.ErrCityIsNotValid
-			}
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, time.Time{}, repo.ErrCityIsNotValid
 		}
 		return uuid.Nil, time.Time{}, err
 	}
+
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action: md.AuditPVZCreated,
+			PVZID:  id,
+			After: &dto.PVZ{
+				ID:               dto.NewOptUUID(id),
+				RegistrationDate: dto.NewOptDateTime(createdAt),
+				City:             req.City,
+				Name:             req.Name,
+				Address:          req.Address,
+				WorkingHours:     req.WorkingHours,
+				Timezone:         req.Timezone,
+				Coordinates:      req.Coordinates,
+				Capacity:         req.Capacity,
+				Status:           dto.NewOptPVZStatus(dto.PVZStatusActive),
+			},
+		},
+	)
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
 	return id, createdAt, nil
 }
 
-func (r *Repository) GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error) {
-	rows, err := r.conn.QueryxContext(ctx, getPVZ, startDate, endDate, limit, (page-1)*limit)
+func (r *Repository) GetPVZ(ctx context.Context, page, limit int64, after *md.PVZCursor, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, error) {
+	offset := (page - 1) * limit
+	afterTime, afterID := cursorArgs(after)
+	if after != nil {
+		offset = 0
+	}
+
+	rows, err := r.conn.QueryxContext(ctx, getPVZ, startDate, endDate, limit, offset, afterTime, afterID, includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -109,129 +310,173 @@ func (r *Repository) GetPVZ(ctx context.Context, page, limit int64, startDate, e
 		}
 	}(rows)
 
-	pvzMap := make(map[string]*dto.PvzGetOKItem)
-	receptionMap := make(map[string]map[string]int)
+	result := make([]*dto.PvzGetOKItem, 0, limit)
+	pvzIdx := make(map[uuid.UUID]int)
+	receptionIdx := make(map[uuid.UUID]int)
 	for rows.Next() {
 		var (
 			pvzID           uuid.UUID
 			pvzCity         string
 			pvzCreatedAt    time.Time
-			receptionID     uuid.UUID
-			receptionDate   time.Time
-			receptionStatus string
-			productID       uuid.UUID
-			productDate     time.Time
-			productType     string
+			pvzName         sql.NullString
+			pvzAddress      sql.NullString
+			workingHours    md.WorkingHours
+			pvzTimezone     sql.NullString
+			pvzLatitude     sql.NullFloat64
+			pvzLongitude    sql.NullFloat64
+			pvzCapacity     sql.NullInt64
+			pvzStatus       string
+			pvzClosedAt     sql.NullTime
+			receptionID     uuid.NullUUID
+			receptionDate   sql.NullTime
+			receptionStatus sql.NullString
+			receptionBy     uuid.NullUUID
+			closedBy        uuid.NullUUID
+			productID       uuid.NullUUID
+			productDate     sql.NullTime
+			productType     sql.NullString
+			productBy       uuid.NullUUID
+			deletedAt       sql.NullTime
+			deletedBy       uuid.NullUUID
+			barcode         sql.NullString
+			sku             sql.NullString
+			quantity        sql.NullInt64
+			weight          sql.NullFloat64
+			scannedAt       sql.NullTime
 		)
 
 		if err := rows.Scan(
 			&pvzID,
 			&pvzCity,
 			&pvzCreatedAt,
+			&pvzName,
+			&pvzAddress,
+			&workingHours,
+			&pvzTimezone,
+			&pvzLatitude,
+			&pvzLongitude,
+			&pvzCapacity,
+			&pvzStatus,
+			&pvzClosedAt,
 			&receptionID,
 			&receptionDate,
 			&receptionStatus,
+			&receptionBy,
+			&closedBy,
 			&productID,
 			&productDate,
 			&productType,
+			&productBy,
+			&deletedAt,
+			&deletedBy,
+			&barcode,
+			&sku,
+			&quantity,
+			&weight,
+			&scannedAt,
 		); err != nil {
 			return nil, err
 		}
 
-		pvzKey := pvzID.String()
-		if _, ok := pvzMap[pvzKey]; !ok {
-			pvzMap[pvzKey] = &dto.PvzGetOKItem{
-				Pvz: dto.OptPVZ{
-					Set: true,
-					Value: dto.PVZ{
-						ID: dto.OptUUID{
-							Set:   true,
-							Value: pvzID,
-						},
-						City: dto.PVZCity(pvzCity),
-						RegistrationDate: dto.OptDateTime{
-							Set:   true,
-							Value: pvzCreatedAt,
-						},
-					},
+		idx, ok := pvzIdx[pvzID]
+		if !ok {
+			result = append(
+				result, &dto.PvzGetOKItem{
+					Pvz: dto.NewOptPVZ(
+						*pvzToDTO(
+							&md.PVZ{
+								ID:               pvzID,
+								RegistrationDate: pvzCreatedAt,
+								City:             pvzCity,
+								Name:             pvzName,
+								Address:          pvzAddress,
+								WorkingHours:     workingHours,
+								Timezone:         pvzTimezone,
+								Latitude:         pvzLatitude,
+								Longitude:        pvzLongitude,
+								Capacity:         pvzCapacity,
+								Status:           pvzStatus,
+								ClosedAt:         pvzClosedAt,
+							},
+						),
+					),
+					Receptions: make([]dto.PvzGetOKItemReceptionsItem, 0),
 				},
-				Receptions: make([]dto.PvzGetOKItemReceptionsItem, 0),
-			}
-			receptionMap[pvzKey] = make(map[string]int)
+			)
+			idx = len(result) - 1
+			pvzIdx[pvzID] = idx
 		}
 
-		if receptionID == uuid.Nil {
+		if !receptionID.Valid {
 			continue
 		}
 
-		receptionKey := receptionID.String()
-		currPVZ := pvzMap[pvzKey]
-		if idx, ok := receptionMap[pvzKey][receptionKey]; ok {
-			currPVZ.Receptions[idx].Products = append(
-				currPVZ.Receptions[idx].Products, dto.Product{
-					ID: dto.OptUUID{
-						Set:   true,
-						Value: productID,
-					},
-					DateTime: dto.OptDateTime{
-						Set:   true,
-						Value: productDate,
+		currPVZ := result[idx]
+		recIdx, ok := receptionIdx[receptionID.UUID]
+		if !ok {
+			currPVZ.Receptions = append(
+				currPVZ.Receptions, dto.PvzGetOKItemReceptionsItem{
+					Reception: dto.OptReception{
+						Set: true,
+						Value: dto.Reception{
+							ID: dto.OptUUID{
+								Set:   true,
+								Value: receptionID.UUID,
+							},
+							DateTime:  receptionDate.Time,
+							PvzId:     pvzID,
+							Status:    dto.ReceptionStatus(receptionStatus.String),
+							CreatedBy: optUUID(receptionBy),
+							ClosedBy:  optUUID(closedBy),
+						},
 					},
-					Type:        dto.ProductType(productType),
-					ReceptionId: receptionID,
+					Products: make([]dto.Product, 0),
 				},
 			)
-		} else {
-			newReception := dto.PvzGetOKItemReceptionsItem{
-				Reception: dto.OptReception{
-					Set: true,
-					Value: dto.Reception{
-						ID: dto.OptUUID{
-							Set:   true,
-							Value: receptionID,
-						},
-						DateTime: receptionDate,
-						PvzId:    pvzID,
-						Status:   dto.ReceptionStatus(receptionStatus),
-					},
+			recIdx = len(currPVZ.Receptions) - 1
+			receptionIdx[receptionID.UUID] = recIdx
+		}
+
+		if !productID.Valid {
+			continue
+		}
+
+		currPVZ.Receptions[recIdx].Products = append(
+			currPVZ.Receptions[recIdx].Products, dto.Product{
+				ID: dto.OptUUID{
+					Set:   true,
+					Value: productID.UUID,
 				},
-				Products: []dto.Product{
-					{
-						ID: dto.OptUUID{
-							Set:   true,
-							Value: productID,
-						},
-						DateTime: dto.OptDateTime{
-							Set:   true,
-							Value: productDate,
-						},
-						Type:        dto.ProductType(productType),
-						ReceptionId: receptionID,
-					},
+				DateTime: dto.OptDateTime{
+					Set:   true,
+					Value: productDate.Time,
 				},
-			}
-			currPVZ.Receptions = append(currPVZ.Receptions, newReception)
-			receptionMap[pvzKey][receptionKey] = len(currPVZ.Receptions) - 1
-		}
+				ScannedAt:   optDateTime(scannedAt),
+				Type:        productType.String,
+				ReceptionId: receptionID.UUID,
+				CreatedBy:   optUUID(productBy),
+				DeletedAt:   optDateTime(deletedAt),
+				DeletedBy:   optUUID(deletedBy),
+				Barcode:     optString(barcode),
+				Sku:         optString(sku),
+				Quantity:    dto.NewOptInt(int(quantity.Int64)),
+				Weight:      optFloat64(weight),
+			},
+		)
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	result := make([]*dto.PvzGetOKItem, 0, len(pvzMap))
-	for _, pvz := range pvzMap {
-		result = append(result, pvz)
-	}
 	return result, nil
 }
 
-func (r *Repository) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error) {
-	tx, err := r.conn.BeginTxx(
-		ctx, &sql.TxOptions{
-			Isolation: sql.LevelRepeatableRead,
-		},
-	)
+// CloseLastReception closes the active reception of the PVZ on behalf of uid. When the reception has a manifest
+// it is passed to check once the reception is locked, an error from check leaves the reception open.
+func (r *Repository) CloseLastReception(ctx context.Context, id, uid uuid.UUID, check repo.ManifestCheck) (*dto.Reception, error) {
+	// Read committed, so products and the manifest read after the lock include everything committed before it.
+	tx, err := r.conn.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +496,27 @@ func (r *Repository) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto
 		return nil, err
 	}
 
-	_, err = tx.ExecContext(ctx, closeReception, res.ID)
+	report, err := checkManifest(ctx, tx, res.ID, check)
+	if err != nil {
+		return nil, err
+	}
+
+	before := receptionToDTO(&res)
+	if err = tx.GetContext(ctx, &res.Status, closeReception, res.ID, nullUUID(uid)); err != nil {
+		return nil, err
+	}
+
+	after := receptionToDTO(&res)
+	after.ClosedBy = optUUID(nullUUID(uid))
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditReceptionClosed,
+			PVZID:       res.PVZID,
+			ReceptionID: res.ID,
+			Before:      before,
+			After:       after,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -260,21 +525,32 @@ func (r *Repository) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto
 		return nil, err
 	}
 
-	return &dto.Reception{
+	out := &dto.Reception{
 		ID: dto.OptUUID{
 			Set:   true,
 			Value: res.ID,
 		},
-		DateTime: res.DateTime,
-		PvzId:    res.PVZID,
-		Status:   dto.ReceptionStatus(res.Status),
-	}, nil
+		DateTime:  res.DateTime,
+		PvzId:     res.PVZID,
+		Status:    dto.ReceptionStatus(res.Status),
+		CreatedBy: optUUID(res.CreatedBy),
+		ClosedBy:  optUUID(nullUUID(uid)),
+	}
+	if report != nil {
+		out.Discrepancies = dto.NewOptDiscrepancyReport(*report)
+	}
+	return out, nil
 }
 
-func (r *Repository) DeleteLastProduct(ctx context.Context, id uuid.UUID) error {
+// CloseIdleReceptions closes up to limit receptions that had no products added or deleted for idleFor since they
+// were created or last reopened. Activity is measured by the server insertion and deletion times of the products,
+// never by the scan time reported by the device. Receptions with a strict manifest are left open, they may only be
+// closed once reconciled. Receptions locked by another transaction are skipped, so several replicas may run it at
+// the same time.
+func (r *Repository) CloseIdleReceptions(ctx context.Context, idleFor time.Duration, reason string, limit int) ([]*md.Reception, error) {
 	tx, err := r.conn.BeginTxx(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer func(tx *sqlx.Tx) {
@@ -283,52 +559,105 @@ func (r *Repository) DeleteLastProduct(ctx context.Context, id uuid.UUID) error
 		}
 	}(tx)
 
-	var reception md.Reception
-	err = tx.GetContext(ctx, &reception, findLastReception, id)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return repo.ErrNoActiveReception
-		}
-		return err
-	}
-
-	res, err := tx.ExecContext(ctx, deleteLastProduct, reception.ID)
-	if err != nil {
-		return err
+	res := make([]*md.Reception, 0)
+	if err = tx.SelectContext(ctx, &res, closeIdleReceptions, idleFor.Seconds(), reason, limit); err != nil {
+		return nil, err
 	}
 
-	if aff, _ := res.RowsAffected(); aff == 0 {
-		return repo.ErrNoItems
+	for _, rec := range res {
+		after := receptionToDTO(rec)
+		before := receptionToDTO(rec)
+		before.Status = "in_progress"
+		err = writeAudit(
+			ctx, tx, &md.AuditEntry{
+				Action:      md.AuditReceptionAutoClosed,
+				PVZID:       rec.PVZID,
+				ReceptionID: rec.ID,
+				Before:      before,
+				After:       after,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return res, nil
 }
 
-func (r *Repository) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error) {
+// ReopenReception moves a closed reception back to in progress on behalf of uid. Only the latest reception
+// of the PVZ closed no longer than window ago can be reopened, the reason is kept in the audit log.
+func (r *Repository) ReopenReception(ctx context.Context, id, uid uuid.UUID, reason string, window time.Duration) (*dto.Reception, error) {
 	tx, err := r.conn.BeginTxx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
+
 	defer func(tx *sqlx.Tx) {
 		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
 			zap.L().Error("Failed to rollback transaction", zap.Error(err))
 		}
 	}(tx)
 
-	var res md.Reception
-	err = tx.GetContext(ctx, &res, findLastReceptionForUpdate, req.PvzId)
+	var (
+		rec        md.Reception
+		closedBy   uuid.NullUUID
+		pvzClosed  bool
+		reopenable bool
+		superseded bool
+	)
+	err = tx.QueryRowContext(ctx, findReceptionForReopen, id, window.Seconds()).Scan(
+		&rec.ID, &rec.Status, &rec.DateTime, &rec.PVZID, &rec.CreatedBy, &closedBy, &pvzClosed, &reopenable, &superseded,
+	)
 	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			return nil, err
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
 		}
-	} else {
+		return nil, err
+	}
+
+	if rec.Status != "closed" {
 		return nil, repo.ErrReceptionStillOpen
 	}
+	if pvzClosed {
+		return nil, repo.ErrPVZClosed
+	}
+	if superseded {
+		return nil, repo.ErrNewerReceptionExists
+	}
+	if !reopenable {
+		return nil, repo.ErrReopenWindowExpired
+	}
+
+	var reopenedAt time.Time
+	if err = tx.GetContext(ctx, &reopenedAt, reopenReception, id, nullUUID(uid), reason); err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == "23505" {
+				return nil, repo.ErrNewerReceptionExists
+			}
+		}
+		return nil, err
+	}
 
-	err = r.conn.GetContext(ctx, &res, createReception, req.PvzId)
+	before := receptionToDTO(&rec)
+	before.ClosedBy = optUUID(closedBy)
+	after := receptionToDTO(&rec)
+	after.Status = dto.ReceptionStatusInProgress
+	after.ReopenedAt = dto.NewOptDateTime(reopenedAt)
+	after.ReopenedBy = optUUID(nullUUID(uid))
+	after.ReopenReason = dto.NewOptString(reason)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditReceptionReopened,
+			PVZID:       rec.PVZID,
+			ReceptionID: rec.ID,
+			Before:      before,
+			After:       after,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -336,21 +665,24 @@ func (r *Repository) CreateReception(ctx context.Context, req *dto.ReceptionsPos
 	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
-
-	return &dto.Reception{
-		ID: dto.OptUUID{
-			Set:   true,
-			Value: res.ID,
-		},
-		DateTime: res.DateTime,
-		PvzId:    res.PVZID,
-		Status:   dto.ReceptionStatus(res.Status),
-	}, nil
+	return after, nil
 }
 
-func (r *Repository) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error) {
+// DeleteLastProduct marks the last live product of the active reception as deleted by uid and returns it.
+func (r *Repository) DeleteLastProduct(ctx context.Context, id, uid uuid.UUID) (*dto.Product, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
 	var reception md.Reception
-	err := r.conn.GetContext(ctx, &reception, findLastReception, req.PvzId)
+	err = tx.GetContext(ctx, &reception, findLastReceptionForUpdate, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repo.ErrNoActiveReception
@@ -359,39 +691,782 @@ func (r *Repository) AddItemToReception(ctx context.Context, req *dto.ProductsPo
 	}
 
 	var res md.Product
-	err = r.conn.GetContext(ctx, &res, addItemToReception, reception.ID, req.Type)
+	err = tx.GetContext(ctx, &res, deleteLastProduct, reception.ID, nullUUID(uid))
 	if err != nil {
-		if pgErr, ok := err.(*pgconn.PgError); ok {
-			if pgErr.Code == "22P02" {
-				return nil, repo.ErrTypeIsNotValid
-			}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNoItems
 		}
 		return nil, err
 	}
 
-	return &dto.Product{
-		ID: dto.OptUUID{
-			Set:   true,
-			Value: res.ID,
+	after := productToDTO(&res)
+	before := productToDTO(&res)
+	before.DeletedAt = dto.OptDateTime{}
+	before.DeletedBy = dto.OptUUID{}
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditProductDeleted,
+			PVZID:       id,
+			ReceptionID: reception.ID,
+			ProductID:   res.ID,
+			Before:      before,
+			After:       after,
 		},
-		DateTime: dto.OptDateTime{
-			Set:   true,
-			Value: res.DateTime,
-		},
-		Type:        dto.ProductType(res.Type),
-		ReceptionId: res.ReceptionId,
-	}, nil
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return after, nil
 }
 
-func (r *Repository) GetPVZList(ctx context.Context) ([]*md.PVZ, error) {
-	var res []*md.PVZ
-	err := r.conn.SelectContext(ctx, &res, listPVZs)
+func (r *Repository) GetReception(ctx context.Context, id uuid.UUID) (*md.Reception, error) {
+	var res md.Reception
+	err := r.conn.GetContext(ctx, &res, getReception, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return res, nil
+			return nil, repo.ErrNotFound
 		}
 		return nil, err
 	}
+	return &res, nil
+}
+
+// DeleteProduct marks a live product of the reception as deleted by uid. The reception is locked
+// for the duration, so it cannot be closed while the product is being deleted.
+func (r *Repository) DeleteProduct(ctx context.Context, receptionID, productID, uid uuid.UUID) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
 
-	return res, err
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var reception md.Reception
+	err = tx.GetContext(ctx, &reception, findReceptionForUpdate, receptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrNoActiveReception
+		}
+		return err
+	}
+
+	var res md.Product
+	err = tx.GetContext(ctx, &res, deleteProduct, receptionID, productID, nullUUID(uid))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrNotFound
+		}
+		return err
+	}
+
+	after := productToDTO(&res)
+	before := productToDTO(&res)
+	before.DeletedAt = dto.OptDateTime{}
+	before.DeletedBy = dto.OptUUID{}
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditProductDeleted,
+			PVZID:       reception.PVZID,
+			ReceptionID: receptionID,
+			ProductID:   res.ID,
+			Before:      before,
+			After:       after,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UndoLastDelete restores the most recently deleted product of the active reception.
+func (r *Repository) UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var reception md.Reception
+	err = tx.GetContext(ctx, &reception, findLastReceptionForUpdate, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNoActiveReception
+		}
+		return nil, err
+	}
+
+	var res md.Product
+	err = tx.GetContext(ctx, &res, undoLastDelete, reception.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNoDeletedItems
+		}
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == "23505" {
+				return nil, repo.ErrAlreadyExists
+			}
+		}
+		return nil, err
+	}
+
+	product := productToDTO(&res)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditProductRestored,
+			PVZID:       id,
+			ReceptionID: reception.ID,
+			ProductID:   res.ID,
+			After:       product,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// CreateReception opens a new reception for the PVZ unless it is closed. A partial unique index guarantees a single
+// reception in progress per PVZ, so a concurrent insert that loses the race is reported as ErrReceptionStillOpen.
+func (r *Repository) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq, uid uuid.UUID) (*dto.Reception, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var status string
+	err = tx.GetContext(ctx, &status, findPVZStatusForShare, req.PvzId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+	if status == md.PVZStatusClosed {
+		return nil, repo.ErrPVZClosed
+	}
+
+	var res md.Reception
+	err = tx.GetContext(ctx, &res, findLastReceptionForUpdate, req.PvzId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	} else {
+		return nil, repo.ErrReceptionStillOpen
+	}
+
+	err = tx.GetContext(ctx, &res, createReception, req.PvzId, nullUUID(uid))
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == "23505" {
+				return nil, repo.ErrReceptionStillOpen
+			}
+		}
+		return nil, err
+	}
+
+	reception := receptionToDTO(&res)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditReceptionOpened,
+			PVZID:       res.PVZID,
+			ReceptionID: res.ID,
+			After:       reception,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return reception, nil
+}
+
+// AddItemToReception adds the product to the active reception of the PVZ. The reception row stays locked
+// until the product is written, so a concurrent close either waits for it or makes it fail with ErrNoActiveReception.
+func (r *Repository) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq, uid uuid.UUID) (*dto.Product, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var reception md.Reception
+	err = tx.GetContext(ctx, &reception, findLastReceptionForUpdate, req.PvzId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNoActiveReception
+		}
+		return nil, err
+	}
+
+	item := &md.Product{
+		Type:     req.Type,
+		Barcode:  nullString(req.Barcode.Value),
+		SKU:      nullString(req.Sku.Value),
+		Quantity: req.Quantity.Or(1),
+		Weight:   sql.NullFloat64{Float64: req.Weight.Value, Valid: req.Weight.Set},
+	}
+	product, err := addItem(ctx, tx, &reception, item, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// AddItemsToReception adds all items to the active reception in a single transaction. Both results are aligned
+// with items: an item whose type is not valid or whose barcode is already in the reception gets a nil product
+// and the reason in errs, it does not abort the rest of the batch.
+func (r *Repository) AddItemsToReception(ctx context.Context, pvzID uuid.UUID, items []dto.ProductBatchItem, uid uuid.UUID) ([]*dto.Product, []error, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var reception md.Reception
+	err = tx.GetContext(ctx, &reception, findLastReceptionForUpdate, pvzID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, repo.ErrNoActiveReception
+		}
+		return nil, nil, err
+	}
+
+	res := make([]*dto.Product, len(items))
+	errs := make([]error, len(items))
+	for i := 0; i < len(items); i++ {
+		item := &md.Product{
+			Type:      items[i].Type,
+			ScannedAt: sql.NullTime{Time: items[i].ScannedAt.Value, Valid: items[i].ScannedAt.Set},
+			Barcode:   nullString(items[i].Barcode.Value),
+			SKU:       nullString(items[i].Sku.Value),
+			Quantity:  items[i].Quantity.Or(1),
+			Weight:    sql.NullFloat64{Float64: items[i].Weight.Value, Valid: items[i].Weight.Set},
+		}
+
+		res[i], err = addItem(ctx, tx, &reception, item, uid)
+		if err != nil {
+			if !errors.Is(err, repo.ErrTypeIsNotValid) && !errors.Is(err, repo.ErrAlreadyExists) {
+				return nil, nil, err
+			}
+			errs[i] = err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	return res, errs, nil
+}
+
+// addItem inserts item into the reception within tx and records it in the audit log.
+// The product is stamped with the insertion time, item.ScannedAt only records the time reported by the device.
+func addItem(ctx context.Context, tx *sqlx.Tx, reception *md.Reception, item *md.Product, uid uuid.UUID) (*dto.Product, error) {
+	var res md.Product
+	err := tx.GetContext(
+		ctx, &res, addItemToReception,
+		reception.ID,
+		item.Type,
+		nullUUID(uid),
+		item.ScannedAt,
+		item.Barcode,
+		item.SKU,
+		item.Quantity,
+		item.Weight,
+	)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		// Nothing is inserted either for an unknown type or for a barcode already in the reception.
+		var active bool
+		if err = tx.GetContext(ctx, &active, isProductTypeActive, item.Type); err != nil {
+			return nil, err
+		}
+		if active {
+			return nil, repo.ErrAlreadyExists
+		}
+		return nil, repo.ErrTypeIsNotValid
+	}
+
+	product := productToDTO(&res)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditProductAdded,
+			PVZID:       reception.PVZID,
+			ReceptionID: reception.ID,
+			ProductID:   res.ID,
+			After:       product,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// FindProductsByBarcode looks the barcode up in all receptions, most recently added products first.
+func (r *Repository) FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error) {
+	var rows []*md.Product
+	if err := r.conn.SelectContext(ctx, &rows, findProductsByBarcode, barcode); err != nil {
+		return nil, err
+	}
+
+	res := make([]*dto.Product, 0, len(rows))
+	for _, p := range rows {
+		res = append(res, productToDTO(p))
+	}
+	return res, nil
+}
+
+func (r *Repository) GetPVZList(ctx context.Context, after *md.PVZCursor, limit int64) ([]*md.PVZ, error) {
+	var lim any
+	if limit > 0 {
+		lim = limit
+	}
+
+	afterTime, afterID := cursorArgs(after)
+
+	var res []*md.PVZ
+	err := r.conn.SelectContext(ctx, &res, listPVZs, afterTime, afterID, lim)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	return res, err
+}
+
+func (r *Repository) GetPVZCity(ctx context.Context, id uuid.UUID) (string, error) {
+	var city string
+	err := r.conn.GetContext(ctx, &city, getPVZCity, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", repo.ErrNotFound
+		}
+		return "", err
+	}
+
+	return city, nil
+}
+
+// GetReceptionSummary builds the summary of the reception. Product counts are the number of accepted units, a
+// product line with a quantity counts that many times.
+func (r *Repository) GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error) {
+	rows, err := r.conn.QueryContext(ctx, getReceptionSummary, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	var res *dto.ReceptionSummary
+	for rows.Next() {
+		var (
+			receptionID    uuid.UUID
+			pvzID          uuid.UUID
+			status         string
+			openedAt       time.Time
+			createdBy      uuid.NullUUID
+			closedAt       sql.NullTime
+			closedBy       uuid.NullUUID
+			autoClosed     bool
+			closeReason    sql.NullString
+			productType    sql.NullString
+			products       int64
+			firstProductAt sql.NullTime
+			lastProductAt  sql.NullTime
+		)
+
+		if err = rows.Scan(
+			&receptionID,
+			&pvzID,
+			&status,
+			&openedAt,
+			&createdBy,
+			&closedAt,
+			&closedBy,
+			&autoClosed,
+			&closeReason,
+			&productType,
+			&products,
+			&firstProductAt,
+			&lastProductAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if res == nil {
+			res = &dto.ReceptionSummary{
+				ReceptionId:   receptionID,
+				PvzId:         pvzID,
+				Status:        status,
+				ProductCounts: make([]dto.ReceptionSummaryProductCountsItem, 0),
+				OpenedAt:      openedAt,
+				CreatedBy:     optUUID(createdBy),
+				AutoClosed:    dto.NewOptBool(autoClosed),
+				CloseReason:   optString(closeReason),
+			}
+			if closedAt.Valid {
+				res.ClosedAt = dto.NewOptDateTime(closedAt.Time)
+				res.DurationSeconds = dto.NewOptInt64(int64(closedAt.Time.Sub(openedAt).Seconds()))
+			}
+			if closedBy.Valid {
+				res.ClosedBy = dto.NewOptUUID(closedBy.UUID)
+			}
+		}
+
+		if !productType.Valid {
+			continue
+		}
+
+		res.TotalProducts += products
+		res.ProductCounts = append(
+			res.ProductCounts, dto.ReceptionSummaryProductCountsItem{
+				Type:  productType.String,
+				Count: products,
+			},
+		)
+
+		if !res.FirstProductAt.Set || firstProductAt.Time.Before(res.FirstProductAt.Value) {
+			res.FirstProductAt = dto.NewOptDateTime(firstProductAt.Time)
+		}
+		if !res.LastProductAt.Set || lastProductAt.Time.After(res.LastProductAt.Value) {
+			res.LastProductAt = dto.NewOptDateTime(lastProductAt.Time)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, repo.ErrNotFound
+	}
+	return res, nil
+}
+
+func (r *Repository) ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	return r.listDictionary(ctx, listCities)
+}
+
+func (r *Repository) CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	return r.createDictionaryEntry(ctx, createCity, md.AuditCityCreated, req)
+}
+
+func (r *Repository) SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	return r.setDictionaryEntryActive(ctx, setCityActive, md.AuditCityUpdated, name, active)
+}
+
+func (r *Repository) DeleteCity(ctx context.Context, name string) error {
+	return r.deleteDictionaryEntry(ctx, deleteCity, md.AuditCityDeleted, name)
+}
+
+func (r *Repository) ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	return r.listDictionary(ctx, listProductTypes)
+}
+
+func (r *Repository) CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	return r.createDictionaryEntry(ctx, createProductType, md.AuditProductTypeCreated, req)
+}
+
+func (r *Repository) SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	return r.setDictionaryEntryActive(ctx, setProductTypeActive, md.AuditProductTypeUpdated, name, active)
+}
+
+func (r *Repository) DeleteProductType(ctx context.Context, name string) error {
+	return r.deleteDictionaryEntry(ctx, deleteProductType, md.AuditProductTypeDeleted, name)
+}
+
+func (r *Repository) listDictionary(ctx context.Context, query string) ([]*dto.DictionaryEntry, error) {
+	rows, err := r.conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	res := make([]*dto.DictionaryEntry, 0)
+	for rows.Next() {
+		entry, err := scanDictionaryEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) createDictionaryEntry(ctx context.Context, query, action string, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	res, err := scanDictionaryEntry(tx.QueryRowContext(ctx, query, req.Name, req.IsActive.Or(true)))
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == "23505" {
+				return nil, repo.ErrAlreadyExists
+			}
+		}
+		return nil, err
+	}
+
+	if err = writeAudit(ctx, tx, &md.AuditEntry{Action: action, After: res}); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) setDictionaryEntryActive(ctx context.Context, query, action, name string, active bool) (*dto.DictionaryEntry, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var (
+		createdAt time.Time
+		wasActive bool
+		nowActive bool
+		entryName string
+	)
+	err = tx.QueryRowContext(ctx, query, name, active).Scan(&entryName, &nowActive, &createdAt, &wasActive)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	res := &dto.DictionaryEntry{
+		Name:      entryName,
+		IsActive:  dto.NewOptBool(nowActive),
+		CreatedAt: dto.NewOptDateTime(createdAt),
+	}
+	prev := *res
+	prev.IsActive = dto.NewOptBool(wasActive)
+	if err = writeAudit(ctx, tx, &md.AuditEntry{Action: action, Before: &prev, After: res}); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) deleteDictionaryEntry(ctx context.Context, query, action, name string) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	res, err := scanDictionaryEntry(tx.QueryRowContext(ctx, query, name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrNotFound
+		}
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == "23503" {
+				return repo.ErrInUse
+			}
+		}
+		return err
+	}
+
+	if err = writeAudit(ctx, tx, &md.AuditEntry{Action: action, Before: res}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error) {
+	rows, err := r.conn.QueryContext(ctx, listAssignments, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	res := make([]*dto.PVZAssignment, 0)
+	for rows.Next() {
+		a, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AssignPVZ is idempotent, assigning an already assigned employee returns the existing assignment
+// without writing an audit entry.
+func (r *Repository) AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	res, err := scanAssignment(tx.QueryRowContext(ctx, assignPVZ, uid, pvzID))
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing was inserted, either the employee is already assigned or the employee or PVZ does not exist.
+		res, err = scanAssignment(tx.QueryRowContext(ctx, getAssignment, uid, pvzID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, repo.ErrNotFound
+			}
+			return nil, err
+		}
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeAudit(ctx, tx, &md.AuditEntry{Action: md.AuditPVZAssigned, PVZID: pvzID, After: res}); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	res, err := scanAssignment(tx.QueryRowContext(ctx, unassignPVZ, uid, pvzID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrNotFound
+		}
+		return err
+	}
+
+	if err = writeAudit(ctx, tx, &md.AuditEntry{Action: md.AuditPVZUnassigned, PVZID: pvzID, Before: res}); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) IsAssigned(ctx context.Context, uid, pvzID uuid.UUID) (bool, error) {
+	var assigned bool
+	if err := r.conn.GetContext(ctx, &assigned, isAssigned, uid, pvzID); err != nil {
+		return false, err
+	}
+	return assigned, nil
 }
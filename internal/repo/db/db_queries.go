@@ -13,30 +13,192 @@ RETURNING id
 `
 
 const getPVZ = `
+WITH page AS (
+	SELECT id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
+	FROM pickup_points
+	WHERE $5::timestamptz IS NULL OR (created_at, id) > ($5, $6::uuid)
+	ORDER BY created_at, id
+	LIMIT $3 OFFSET $4
+)
 SELECT 
 	p.id,
 	p.city,
 	p.created_at AS registration_date,
+	p.name,
+	p.address,
+	p.working_hours,
+	p.timezone,
+	p.latitude,
+	p.longitude,
+	p.capacity,
+	p.status AS pvz_status,
+	p.closed_at AS pvz_closed_at,
 	r.id AS reception_id,
 	r.created_at AS reception_date,
 	r.status,
+	r.created_by AS reception_created_by,
+	r.closed_by AS reception_closed_by,
 	pr.id AS product_id,
 	pr.created_at AS product_date,
-	pr.type
-FROM pickup_points p
-LEFT JOIN receptions r ON p.id = r.pickup_point_id
-LEFT JOIN products pr ON r.id = pr.reception_id
-WHERE r.created_at BETWEEN $1 AND $2
-ORDER BY p.created_at
-LIMIT $3 OFFSET $4
+	pr.type,
+	pr.created_by AS product_created_by,
+	pr.deleted_at AS product_deleted_at,
+	pr.deleted_by AS product_deleted_by,
+	pr.barcode,
+	pr.sku,
+	pr.quantity,
+	pr.weight,
+	pr.scanned_at AS product_scanned_at
+FROM page p
+LEFT JOIN receptions r ON p.id = r.pickup_point_id AND r.created_at BETWEEN $1 AND $2
+LEFT JOIN products pr ON r.id = pr.reception_id AND ($7 OR pr.deleted_at IS NULL)
+ORDER BY p.created_at, p.id, r.created_at, r.id, pr.created_at, pr.id
+`
+
+const createRefreshToken = `
+INSERT INTO refresh_tokens (user_id, role, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+const findRefreshTokenForUpdate = `
+SELECT user_id, role, expires_at
+FROM refresh_tokens
+WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > NOW()
+FOR UPDATE
+`
+
+const revokeRefreshToken = `
+UPDATE refresh_tokens
+SET revoked_at = NOW()
+WHERE token_hash = $1 AND user_id = $2 AND revoked_at IS NULL
+`
+
+const revokeToken = `
+INSERT INTO revoked_tokens (jti, expires_at)
+VALUES ($1, $2)
+ON CONFLICT (jti) DO NOTHING
+`
+
+const isTokenRevoked = `
+SELECT EXISTS (
+	SELECT 1 FROM revoked_tokens WHERE jti = $1
+)
+`
+
+const deleteExpiredIdempotencyKeys = `
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND expires_at <= NOW()
+`
+
+const claimIdempotencyKey = `
+INSERT INTO idempotency_keys (user_id, key, request_hash, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, key) DO NOTHING
+`
+
+const getIdempotencyKey = `
+SELECT
+	user_id,
+	key,
+	request_hash,
+	COALESCE(status_code, 0) AS status_code,
+	COALESCE(content_type, '') AS content_type,
+	response,
+	completed_at IS NOT NULL AS completed,
+	expires_at
+FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND expires_at > NOW()
+`
+
+const completeIdempotencyKey = `
+UPDATE idempotency_keys
+SET status_code = $3, response = $4, content_type = NULLIF($5, ''), completed_at = NOW()
+WHERE user_id = $1 AND key = $2 AND completed_at IS NULL
+`
+
+const releaseIdempotencyKey = `
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND completed_at IS NULL
 `
 
 const createPVZ = `
-INSERT INTO pickup_points (city)
-VALUES ($1)
+INSERT INTO pickup_points (city, name, address, working_hours, timezone, latitude, longitude, capacity)
+SELECT name, $2, $3, $4, $5, $6, $7, $8 FROM cities WHERE name = $1 AND is_active
 RETURNING id, created_at
 `
 
+const getPVZByID = `
+SELECT id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
+FROM pickup_points
+WHERE id = $1
+`
+
+const findPVZForUpdate = `
+SELECT id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
+FROM pickup_points
+WHERE id = $1
+FOR UPDATE
+`
+
+const findNearbyPVZs = `
+SELECT *
+FROM (
+	SELECT
+		id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at,
+		2 * 6371000 * ASIN(SQRT(LEAST(1,
+			POWER(SIN(RADIANS(latitude - $1::float8) / 2), 2) +
+			COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2::float8) / 2), 2)
+		))) AS distance
+	FROM pickup_points
+	WHERE status = 'active'
+		AND latitude IS NOT NULL
+		AND latitude BETWEEN $1 - DEGREES($3::float8 / 6371000) AND $1 + DEGREES($3 / 6371000)
+) p
+WHERE distance <= $3 AND ($5::float8 IS NULL OR (distance, id) > ($5, $6::uuid))
+ORDER BY distance, id
+LIMIT $4
+`
+
+const findPVZStatusForShare = `
+SELECT status
+FROM pickup_points
+WHERE id = $1
+FOR SHARE
+`
+
+const isCityActive = `
+SELECT EXISTS (
+	SELECT 1 FROM cities WHERE name = $1 AND is_active
+)
+`
+
+const updatePVZ = `
+UPDATE pickup_points
+SET city = COALESCE($2, city),
+	name = COALESCE($3, name),
+	address = COALESCE($4, address),
+	working_hours = COALESCE($5, working_hours),
+	timezone = COALESCE($6, timezone),
+	latitude = COALESCE($7, latitude),
+	longitude = COALESCE($8, longitude),
+	capacity = COALESCE($9, capacity)
+WHERE id = $1
+RETURNING id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
+`
+
+const hasOpenReception = `
+SELECT EXISTS (
+	SELECT 1 FROM receptions WHERE pickup_point_id = $1 AND status = 'in_progress'
+)
+`
+
+const decommissionPVZ = `
+UPDATE pickup_points
+SET status = 'closed', closed_at = NOW(), closed_by = $2
+WHERE id = $1
+RETURNING id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
+`
+
 const findLastReception = `
 SELECT 
 	id,
@@ -54,42 +216,338 @@ SELECT
 	id,
 	status,
 	created_at,
-	pickup_point_id
+	pickup_point_id,
+	created_by
 FROM receptions 
 WHERE pickup_point_id = $1 AND status = 'in_progress'
 FOR UPDATE
 `
 
+const getReception = `
+SELECT 
+	id,
+	status,
+	created_at,
+	pickup_point_id,
+	created_by
+FROM receptions 
+WHERE id = $1
+`
+
+const findReceptionForUpdate = `
+SELECT 
+	id,
+	status,
+	created_at,
+	pickup_point_id,
+	created_by
+FROM receptions 
+WHERE id = $1 AND status = 'in_progress'
+FOR UPDATE
+`
+
 const createReception = `
-INSERT INTO receptions (pickup_point_id, status)
-VALUES ($1, 'in_progress')
-RETURNING id, status, created_at, pickup_point_id
+INSERT INTO receptions (pickup_point_id, status, created_by)
+VALUES ($1, 'in_progress', $2)
+RETURNING id, status, created_at, pickup_point_id, created_by
 `
 
 const closeReception = `
 UPDATE receptions 
-SET status = 'closed', closed_at = NOW() 
+SET status = 'closed', closed_at = NOW(), closed_by = $2
 WHERE id = $1
+RETURNING status
+`
+
+const closeIdleReceptions = `
+WITH idle AS (
+	SELECT r.id
+	FROM receptions r
+	WHERE r.status = 'in_progress'
+		AND GREATEST(r.created_at, r.reopened_at) < NOW() - make_interval(secs => $1)
+		AND NOT EXISTS (
+			SELECT 1
+			FROM products p
+			WHERE p.reception_id = r.id AND GREATEST(p.created_at, p.deleted_at) >= NOW() - make_interval(secs => $1)
+		)
+		AND NOT EXISTS (
+			SELECT 1
+			FROM manifests m
+			WHERE m.reception_id = r.id AND m.strict
+		)
+	ORDER BY r.created_at
+	LIMIT $3
+	FOR UPDATE OF r SKIP LOCKED
+)
+UPDATE receptions r
+SET status = 'closed', closed_at = NOW(), auto_closed = TRUE, close_reason = $2
+FROM idle
+WHERE r.id = idle.id
+RETURNING r.id, r.status, r.created_at, r.pickup_point_id, r.created_by
+`
+
+const findReceptionForReopen = `
+SELECT
+	r.id,
+	r.status,
+	r.created_at,
+	r.pickup_point_id,
+	r.created_by,
+	r.closed_by,
+	p.status = 'closed' AS pvz_closed,
+	COALESCE(r.closed_at >= NOW() - make_interval(secs => $2), FALSE) AS reopenable,
+	EXISTS (
+		SELECT 1
+		FROM receptions n
+		WHERE n.pickup_point_id = r.pickup_point_id AND n.created_at > r.created_at AND n.id <> r.id
+	) AS superseded
+FROM receptions r
+JOIN pickup_points p ON p.id = r.pickup_point_id
+WHERE r.id = $1
+FOR UPDATE OF r
+FOR SHARE OF p
+`
+
+const reopenReception = `
+UPDATE receptions
+SET status = 'in_progress',
+	closed_at = NULL,
+	closed_by = NULL,
+	auto_closed = FALSE,
+	close_reason = NULL,
+	reopened_at = NOW(),
+	reopened_by = $2,
+	reopen_reason = $3
+WHERE id = $1
+RETURNING reopened_at
 `
 
 const addItemToReception = `
-INSERT INTO products (reception_id, type)
-VALUES ($1, $2)
-RETURNING id, reception_id, type, created_at
+INSERT INTO products (reception_id, type, created_by, created_at, scanned_at, barcode, sku, quantity, weight)
+SELECT $1, name, $3, clock_timestamp(), $4, $5, $6, $7, $8 FROM product_types WHERE name = $2 AND is_active
+ON CONFLICT (reception_id, barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL DO NOTHING
+RETURNING id, reception_id, type, created_at, scanned_at, created_by, barcode, sku, quantity, weight
+`
+
+const isProductTypeActive = `
+SELECT EXISTS (
+	SELECT 1 FROM product_types WHERE name = $1 AND is_active
+)
+`
+
+const findProductsByBarcode = `
+SELECT id, reception_id, type, created_at, scanned_at, created_by, deleted_at, deleted_by, barcode, sku, quantity, weight
+FROM products
+WHERE barcode = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC, id
 `
 
 const deleteLastProduct = `
-DELETE FROM products 
+UPDATE products 
+SET deleted_at = NOW(), deleted_by = $2
 WHERE id IN (
 	SELECT id 
 	FROM products 
-	WHERE reception_id = $1 
+	WHERE reception_id = $1 AND deleted_at IS NULL
 	ORDER BY created_at DESC 
 	LIMIT 1
 )
+RETURNING id, reception_id, type, created_at, scanned_at, created_by, deleted_at, deleted_by, barcode, sku, quantity, weight
+`
+
+const deleteProduct = `
+UPDATE products 
+SET deleted_at = NOW(), deleted_by = $3
+WHERE id = $2 AND reception_id = $1 AND deleted_at IS NULL
+RETURNING id, reception_id, type, created_at, scanned_at, created_by, deleted_at, deleted_by, barcode, sku, quantity, weight
+`
+
+const undoLastDelete = `
+UPDATE products 
+SET deleted_at = NULL, deleted_by = NULL
+WHERE id IN (
+	SELECT id 
+	FROM products 
+	WHERE reception_id = $1 AND deleted_at IS NOT NULL
+	ORDER BY deleted_at DESC 
+	LIMIT 1
+)
+RETURNING id, reception_id, type, created_at, scanned_at, created_by, deleted_at, deleted_by, barcode, sku, quantity, weight
 `
 
 const listPVZs = `
-SELECT id, city, created_at
+SELECT id, city, created_at, name, address, working_hours, timezone, latitude, longitude, capacity, status, closed_at
 FROM pickup_points
+WHERE $1::timestamptz IS NULL OR (created_at, id) > ($1, $2::uuid)
+ORDER BY created_at, id
+LIMIT $3
+`
+
+const getPVZCity = `
+SELECT city
+FROM pickup_points
+WHERE id = $1
+`
+
+const getReceptionSummary = `
+SELECT
+	r.id,
+	r.pickup_point_id,
+	r.status,
+	r.created_at,
+	r.created_by,
+	r.closed_at,
+	r.closed_by,
+	r.auto_closed,
+	r.close_reason,
+	pr.type,
+	COALESCE(SUM(pr.quantity), 0) AS products,
+	MIN(pr.created_at) AS first_product_at,
+	MAX(pr.created_at) AS last_product_at
+FROM receptions r
+LEFT JOIN products pr ON r.id = pr.reception_id AND pr.deleted_at IS NULL
+WHERE r.id = $1
+GROUP BY r.id, pr.type
+ORDER BY pr.type
+`
+
+const listCities = `
+SELECT name, is_active, created_at
+FROM cities
+ORDER BY name
+`
+
+const createCity = `
+INSERT INTO cities (name, is_active)
+VALUES ($1, $2)
+RETURNING name, is_active, created_at
+`
+
+const setCityActive = `
+UPDATE cities t
+SET is_active = $2
+FROM (SELECT name, is_active FROM cities WHERE name = $1 FOR UPDATE) prev
+WHERE t.name = prev.name
+RETURNING t.name, t.is_active, t.created_at, prev.is_active
+`
+
+const deleteCity = `
+DELETE FROM cities
+WHERE name = $1
+RETURNING name, is_active, created_at
+`
+
+const listProductTypes = `
+SELECT name, is_active, created_at
+FROM product_types
+ORDER BY name
+`
+
+const createProductType = `
+INSERT INTO product_types (name, is_active)
+VALUES ($1, $2)
+RETURNING name, is_active, created_at
+`
+
+const setProductTypeActive = `
+UPDATE product_types t
+SET is_active = $2
+FROM (SELECT name, is_active FROM product_types WHERE name = $1 FOR UPDATE) prev
+WHERE t.name = prev.name
+RETURNING t.name, t.is_active, t.created_at, prev.is_active
+`
+
+const deleteProductType = `
+DELETE FROM product_types
+WHERE name = $1
+RETURNING name, is_active, created_at
+`
+
+const listAssignments = `
+SELECT user_id, pickup_point_id, created_at
+FROM pvz_assignments
+WHERE user_id = $1
+ORDER BY created_at, pickup_point_id
+`
+
+const assignPVZ = `
+INSERT INTO pvz_assignments (user_id, pickup_point_id)
+SELECT u.id, p.id
+FROM users u, pickup_points p
+WHERE u.id = $1 AND u.role = 'employee' AND p.id = $2
+ON CONFLICT (user_id, pickup_point_id) DO NOTHING
+RETURNING user_id, pickup_point_id, created_at
+`
+
+const getAssignment = `
+SELECT user_id, pickup_point_id, created_at
+FROM pvz_assignments
+WHERE user_id = $1 AND pickup_point_id = $2
+`
+
+const unassignPVZ = `
+DELETE FROM pvz_assignments
+WHERE user_id = $1 AND pickup_point_id = $2
+RETURNING user_id, pickup_point_id, created_at
+`
+
+const isAssigned = `
+SELECT EXISTS (
+	SELECT 1 FROM pvz_assignments WHERE user_id = $1 AND pickup_point_id = $2
+)
+`
+
+const insertAudit = `
+INSERT INTO audit_log (actor_id, actor_role, action, pvz_id, reception_id, product_id, request_id, before, after)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+const listAudit = `
+SELECT id, actor_id, actor_role, action, pvz_id, reception_id, product_id, request_id, before, after, created_at
+FROM audit_log
+WHERE ($1::uuid IS NULL OR pvz_id = $1)
+	AND ($2::uuid IS NULL OR actor_id = $2)
+	AND ($3::text IS NULL OR action = $3)
+	AND ($4::timestamptz IS NULL OR created_at >= $4)
+	AND ($5::timestamptz IS NULL OR created_at <= $5)
+ORDER BY id DESC
+LIMIT $6 OFFSET $7
+`
+
+const upsertManifest = `
+INSERT INTO manifests (reception_id, strict, created_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (reception_id) DO UPDATE SET strict = EXCLUDED.strict, created_at = NOW(), created_by = EXCLUDED.created_by
+RETURNING created_at
+`
+
+const deleteManifestItems = `
+DELETE FROM manifest_items
+WHERE reception_id = $1
+`
+
+const insertManifestItem = `
+INSERT INTO manifest_items (reception_id, type, barcode, quantity)
+VALUES ($1, $2, $3, $4)
+`
+
+const getManifest = `
+SELECT strict, created_at, created_by
+FROM manifests
+WHERE reception_id = $1
+`
+
+const getManifestItems = `
+SELECT type, barcode, quantity
+FROM manifest_items
+WHERE reception_id = $1
+ORDER BY id
+`
+
+const getReceptionProductCounts = `
+SELECT type, barcode, SUM(quantity) AS quantity
+FROM products
+WHERE reception_id = $1 AND deleted_at IS NULL
+GROUP BY type, barcode
+ORDER BY type, barcode
 `
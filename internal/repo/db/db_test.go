@@ -7,12 +7,14 @@ import (
 	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	md "github.com/JMURv/avito-spring/internal/models"
 	repo2 "github.com/JMURv/avito-spring/internal/repo"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 	sqlmock "gopkg.in/DATA-DOG/go-sqlmock.v1"
 	"regexp"
+	"sync"
 	"testing"
 	"time"
 )
@@ -128,9 +130,12 @@ func TestRepository_CreateUser(t *testing.T) {
 			name: "Success",
 			setup: func() {
 				rows := sqlmock.NewRows([]string{"id"}).AddRow(testID.String())
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(createUser)).
 					WithArgs(req.Email, req.Password, req.Role).
 					WillReturnRows(rows)
+				expectAudit(mock, md.AuditUserRegistered)
+				mock.ExpectCommit()
 			},
 			wantErr: nil,
 			wantID:  testID,
@@ -138,9 +143,11 @@ func TestRepository_CreateUser(t *testing.T) {
 		{
 			name: "QueryError",
 			setup: func() {
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(createUser)).
 					WithArgs(req.Email, req.Password, req.Role).
 					WillReturnError(testErr)
+				mock.ExpectRollback()
 			},
 			wantErr: testErr,
 			wantID:  uuid.Nil,
@@ -161,6 +168,7 @@ func TestRepository_CreateUser(t *testing.T) {
 					require.NoError(t, err)
 					require.Equal(t, tt.wantID, id)
 				}
+				require.NoError(t, mock.ExpectationsWereMet())
 			},
 		)
 	}
@@ -190,27 +198,41 @@ func TestRepository_CreatePVZ(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			req:  &dto.PVZ{City: dto.PVZCity(testCity)},
+			req: &dto.PVZ{
+				City:         testCity,
+				Name:         dto.NewOptString("ПВЗ на Тверской"),
+				WorkingHours: dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"}},
+				Timezone:     dto.NewOptString("Europe/Moscow"),
+				Coordinates:  dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7658, Longitude: 37.6049}),
+				Capacity:     dto.NewOptInt(500),
+			},
 			setup: func() {
 				rows := sqlmock.NewRows([]string{"id", "created_at"}).
 					AddRow(testID.String(), testTime)
 
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(createPVZ)).
-					WithArgs(testCity).
+					WithArgs(
+						testCity, "ПВЗ на Тверской", nil, md.WorkingHours{{Day: "mon", Open: "09:00", Close: "21:00"}},
+						"Europe/Moscow", 55.7658, 37.6049, 500,
+					).
 					WillReturnRows(rows)
+				expectAudit(mock, md.AuditPVZCreated)
+				mock.ExpectCommit()
 			},
 			wantID:   testID,
 			wantTime: testTime,
 			wantErr:  nil,
 		},
 		{
-			name: "InvalidCity_PG_22P02",
+			name: "Unknown or inactive city",
 			req:  &dto.PVZ{City: "123_invalid"},
 			setup: func() {
-				pgErr := &pgconn.PgError{Code: "22P02"}
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(createPVZ)).
-					WithArgs("123_invalid").
-					WillReturnError(pgErr)
+					WithArgs("123_invalid", nil, nil, nil, nil, nil, nil, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}))
+				mock.ExpectRollback()
 			},
 			wantID:   uuid.Nil,
 			wantTime: time.Time{},
@@ -220,9 +242,11 @@ func TestRepository_CreatePVZ(t *testing.T) {
 			name: "Generic DB Error",
 			req:  &dto.PVZ{City: "St.Petersburg"},
 			setup: func() {
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(createPVZ)).
-					WithArgs("St.Petersburg").
+					WithArgs("St.Petersburg", nil, nil, nil, nil, nil, nil, nil).
 					WillReturnError(testErr)
+				mock.ExpectRollback()
 			},
 			wantID:   uuid.Nil,
 			wantTime: time.Time{},
@@ -245,6 +269,7 @@ func TestRepository_CreatePVZ(t *testing.T) {
 
 				require.Equal(t, tt.wantID, id)
 				require.Equal(t, tt.wantTime, createdAt)
+				require.NoError(t, mock.ExpectationsWereMet())
 			},
 		)
 	}
@@ -267,6 +292,7 @@ func TestRepository_GetPVZ(t *testing.T) {
 	testPVZID := uuid.New().String()
 	testReceptionID := uuid.New().String()
 	testProductID := uuid.New().String()
+	testUserID := uuid.New().String()
 
 	tests := []struct {
 		name    string
@@ -278,18 +304,21 @@ func TestRepository_GetPVZ(t *testing.T) {
 			setup: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"pickup_point_id", "pvz_city", "pvz_created_at",
-						"reception_id", "reception_date", "reception_status",
-						"product_id", "product_date", "product_type",
+						"pickup_point_id", "pvz_city", "pvz_created_at", "name", "address", "working_hours", "timezone", "latitude", "longitude", "capacity", "pvz_status", "pvz_closed_at",
+						"reception_id", "reception_date", "reception_status", "reception_created_by", "reception_closed_by",
+						"product_id", "product_date", "product_type", "product_created_by", "product_deleted_at", "product_deleted_by",
+						"barcode", "sku", "quantity", "weight", "product_scanned_at",
 					},
 				).AddRow(
-					testPVZID, "Moscow", time.Now(),
-					testReceptionID, time.Now(), "open",
-					testProductID, time.Now(), "electronics",
+					testPVZID, "Moscow", time.Now(), "ПВЗ на Тверской", nil, `[{"day":"mon","open":"09:00","close":"21:00"}]`,
+					"Europe/Moscow", 55.7658, 37.6049, 500, "active", nil,
+					testReceptionID, time.Now(), "open", testUserID, nil,
+					testProductID, time.Now(), "electronics", testUserID, nil, nil,
+					"4006381333931", "SKU-1", 2, "1.250", nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(getPVZ)).
-					WithArgs(start, end, limit, (page-1)*limit).
+					WithArgs(start, end, limit, (page-1)*limit, nil, nil, false).
 					WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -298,7 +327,7 @@ func TestRepository_GetPVZ(t *testing.T) {
 			name: "DB error",
 			setup: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(getPVZ)).
-					WithArgs(start, end, limit, (page-1)*limit).
+					WithArgs(start, end, limit, (page-1)*limit, nil, nil, false).
 					WillReturnError(errors.New("db error"))
 			},
 			wantErr: true,
@@ -308,18 +337,20 @@ func TestRepository_GetPVZ(t *testing.T) {
 			setup: func() {
 				rows := sqlmock.NewRows(
 					[]string{
-						"pickup_point_id", "pvz_city", "pvz_created_at",
-						"reception_id", "reception_date", "reception_status",
-						"product_id", "product_date", "product_type",
+						"pickup_point_id", "pvz_city", "pvz_created_at", "name", "address", "working_hours", "timezone", "latitude", "longitude", "capacity", "pvz_status", "pvz_closed_at",
+						"reception_id", "reception_date", "reception_status", "reception_created_by", "reception_closed_by",
+						"product_id", "product_date", "product_type", "product_created_by", "product_deleted_at", "product_deleted_by",
+						"barcode", "sku", "quantity", "weight", "product_scanned_at",
 					},
 				).AddRow(
-					"invalid-uuid", "Moscow", time.Now(),
-					testReceptionID, time.Now(), "open",
-					testProductID, time.Now(), "electronics",
+					"invalid-uuid", "Moscow", time.Now(), nil, nil, nil, nil, nil, nil, nil, "active", nil,
+					testReceptionID, time.Now(), "open", testUserID, nil,
+					testProductID, time.Now(), "electronics", testUserID, nil, nil,
+					"4006381333931", "SKU-1", 2, "1.250", nil,
 				)
 
 				mock.ExpectQuery(regexp.QuoteMeta(getPVZ)).
-					WithArgs(start, end, limit, (page-1)*limit).
+					WithArgs(start, end, limit, (page-1)*limit, nil, nil, false).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -330,7 +361,7 @@ func TestRepository_GetPVZ(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				res, err := repo.GetPVZ(ctx, page, limit, start, end)
+				res, err := repo.GetPVZ(ctx, page, limit, nil, start, end, false)
 				if tt.wantErr {
 					require.Error(t, err)
 					require.Nil(t, res)
@@ -338,12 +369,139 @@ func TestRepository_GetPVZ(t *testing.T) {
 					require.NoError(t, err)
 					require.NotNil(t, res)
 					require.GreaterOrEqual(t, len(res), 1)
+					require.Equal(t, "ПВЗ на Тверской", res[0].Pvz.Value.Name.Value)
+					require.Len(t, res[0].Pvz.Value.WorkingHours, 1)
+					require.Equal(t, "Europe/Moscow", res[0].Pvz.Value.Timezone.Value)
+					require.Equal(t, 55.7658, res[0].Pvz.Value.Coordinates.Value.Latitude)
+					require.Equal(t, 500, res[0].Pvz.Value.Capacity.Value)
 				}
 			},
 		)
 	}
 }
 
+func TestRepository_GetPVZ_Keyset(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	start := time.Now().Add(-24 * time.Hour)
+	end := time.Now()
+	after := &md.PVZCursor{CreatedAt: time.Now().Add(-time.Hour), ID: uuid.New()}
+
+	mock.ExpectQuery(regexp.QuoteMeta(getPVZ)).
+		WithArgs(start, end, int64(5), int64(0), after.CreatedAt, after.ID.String(), false).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{
+					"pickup_point_id", "pvz_city", "pvz_created_at", "name", "address", "working_hours", "timezone", "latitude", "longitude", "capacity", "pvz_status", "pvz_closed_at",
+					"reception_id", "reception_date", "reception_status", "reception_created_by", "reception_closed_by",
+					"product_id", "product_date", "product_type", "product_created_by", "product_deleted_at", "product_deleted_by",
+					"barcode", "sku", "quantity", "weight", "product_scanned_at",
+				},
+			),
+		)
+
+	res, err := repo.GetPVZ(ctx, 3, 5, after, start, end, false)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	mock.ExpectQuery(regexp.QuoteMeta(listPVZs)).
+		WithArgs(after.CreatedAt, after.ID.String(), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "city", "created_at"}))
+
+	list, err := repo.GetPVZList(ctx, after, 5)
+	require.NoError(t, err)
+	require.Empty(t, list)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetPVZ_Grouping(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	start := time.Now().Add(-24 * time.Hour)
+	end := time.Now()
+	now := time.Now()
+
+	firstPVZ := uuid.New()
+	secondPVZ := uuid.New()
+	thirdPVZ := uuid.New()
+	fullReception := uuid.New()
+	emptyReception := uuid.New()
+	opener := uuid.New()
+	closer := uuid.New()
+
+	rows := sqlmock.NewRows(
+		[]string{
+			"id", "city", "registration_date", "name", "address", "working_hours", "timezone", "latitude", "longitude", "capacity", "pvz_status", "pvz_closed_at",
+			"reception_id", "reception_date", "status", "reception_created_by", "reception_closed_by",
+			"product_id", "product_date", "type", "product_created_by", "product_deleted_at", "product_deleted_by",
+			"barcode", "sku", "quantity", "weight", "product_scanned_at",
+		},
+	).
+		AddRow(
+			firstPVZ.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, "active", nil, fullReception.String(), now, "closed", opener.String(), closer.String(),
+			uuid.NewString(), now, "обувь", opener.String(), nil, nil, "4006381333931", "SKU-1", 2, "1.250", now.Add(-time.Hour),
+		).
+		AddRow(
+			firstPVZ.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, "active", nil, fullReception.String(), now, "closed", opener.String(), closer.String(),
+			uuid.NewString(), now, "одежда", closer.String(), now, opener.String(), nil, nil, 1, nil, nil,
+		).
+		AddRow(firstPVZ.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, "active", nil, emptyReception.String(), now, "in_progress", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).
+		AddRow(secondPVZ.String(), "Казань", now, "Казань-1", "ул. Баумана, 1", nil, nil, 55.7887, 49.1221, nil, "closed", now, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).
+		AddRow(thirdPVZ.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, "active", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getPVZ)).
+		WithArgs(start, end, int64(3), int64(3), nil, nil, true).
+		WillReturnRows(rows)
+
+	res, err := repo.GetPVZ(ctx, 2, 3, nil, start, end, true)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	require.Equal(t, firstPVZ, res[0].Pvz.Value.ID.Value)
+	require.Equal(t, secondPVZ, res[1].Pvz.Value.ID.Value)
+	require.Equal(t, thirdPVZ, res[2].Pvz.Value.ID.Value)
+
+	require.Len(t, res[0].Receptions, 2)
+	require.Len(t, res[0].Receptions[0].Products, 2)
+	require.Equal(t, dto.NewOptUUID(opener), res[0].Receptions[0].Reception.Value.CreatedBy)
+	require.Equal(t, dto.NewOptUUID(closer), res[0].Receptions[0].Reception.Value.ClosedBy)
+	require.Equal(t, dto.NewOptUUID(opener), res[0].Receptions[0].Products[0].CreatedBy)
+	require.Equal(t, dto.NewOptUUID(closer), res[0].Receptions[0].Products[1].CreatedBy)
+	require.False(t, res[0].Receptions[0].Products[0].DeletedAt.Set)
+	require.Equal(t, dto.NewOptUUID(opener), res[0].Receptions[0].Products[1].DeletedBy)
+	require.Equal(t, dto.NewOptString("4006381333931"), res[0].Receptions[0].Products[0].Barcode)
+	require.Equal(t, dto.NewOptInt(2), res[0].Receptions[0].Products[0].Quantity)
+	require.Equal(t, dto.NewOptFloat64(1.25), res[0].Receptions[0].Products[0].Weight)
+	require.False(t, res[0].Receptions[0].Products[1].Barcode.Set)
+	require.Equal(t, now.Add(-time.Hour), res[0].Receptions[0].Products[0].ScannedAt.Value)
+	require.False(t, res[0].Receptions[0].Products[1].ScannedAt.Set)
+	require.False(t, res[0].Receptions[1].Reception.Value.CreatedBy.Set)
+	require.Equal(t, emptyReception, res[0].Receptions[1].Reception.Value.ID.Value)
+	require.Empty(t, res[0].Receptions[1].Products)
+
+	require.Empty(t, res[1].Receptions)
+	require.Empty(t, res[2].Receptions)
+	require.Equal(t, dto.NewOptString("ул. Баумана, 1"), res[1].Pvz.Value.Address)
+	require.Equal(t, dto.NewOptPVZStatus(dto.PVZStatusClosed), res[1].Pvz.Value.Status)
+	require.True(t, res[1].Pvz.Value.ClosedAt.Set)
+	require.True(t, res[1].Pvz.Value.Coordinates.Set)
+	require.False(t, res[0].Pvz.Value.Coordinates.Set)
+	require.Nil(t, res[2].Pvz.Value.WorkingHours)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_CloseLastReception(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -354,6 +512,7 @@ func TestRepository_CloseLastReception(t *testing.T) {
 	ctx := context.Background()
 
 	receptionID := uuid.New()
+	uid := uuid.New()
 	testReception := dto.Reception{
 		ID: dto.OptUUID{
 			Value: receptionID,
@@ -364,11 +523,28 @@ func TestRepository_CloseLastReception(t *testing.T) {
 		Status:   "in_progress",
 	}
 
+	noCheck := func(*dto.Manifest, []*md.ProductCount) (*dto.DiscrepancyReport, error) {
+		return &dto.DiscrepancyReport{ReceptionId: receptionID, Reconciled: true}, nil
+	}
+	expectManifest := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(getManifest)).
+			WithArgs(receptionID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"strict", "created_at", "created_by"}).AddRow(true, time.Now(), nil))
+		mock.ExpectQuery(regexp.QuoteMeta(getManifestItems)).
+			WithArgs(receptionID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"type", "barcode", "quantity"}).AddRow("обувь", nil, 2))
+		mock.ExpectQuery(regexp.QuoteMeta(getReceptionProductCounts)).
+			WithArgs(receptionID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"type", "barcode", "quantity"}).AddRow("обувь", nil, 1))
+	}
+
 	tests := []struct {
 		name       string
 		setup      func()
+		check      repo2.ManifestCheck
 		wantErr    error
 		wantNilRes bool
+		wantReport bool
 	}{
 		{
 			name: "Success",
@@ -385,15 +561,65 @@ func TestRepository_CloseLastReception(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(getManifest)).
+					WithArgs(receptionID.String()).
+					WillReturnError(sql.ErrNoRows)
 
-				mock.ExpectExec(regexp.QuoteMeta(closeReception)).
-					WithArgs(testReception.ID.Value.String()).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(closeReception)).
+					WithArgs(testReception.ID.Value.String(), uid).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("closed"))
+				expectAudit(mock, md.AuditReceptionClosed)
+
+				mock.ExpectCommit()
+			},
+			check:      noCheck,
+			wantErr:    nil,
+			wantNilRes: false,
+		},
+		{
+			name: "Manifest report is attached",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(receptionID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
+							AddRow(receptionID.String(), testReception.DateTime, testReception.PvzId.String(), "in_progress"),
+					)
+				expectManifest()
+
+				mock.ExpectQuery(regexp.QuoteMeta(closeReception)).
+					WithArgs(testReception.ID.Value.String(), uid).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("closed"))
+				expectAudit(mock, md.AuditReceptionClosed)
 
 				mock.ExpectCommit()
 			},
+			check:      noCheck,
 			wantErr:    nil,
 			wantNilRes: false,
+			wantReport: true,
+		},
+		{
+			name: "Manifest check refuses to close",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(receptionID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
+							AddRow(receptionID.String(), testReception.DateTime, testReception.PvzId.String(), "in_progress"),
+					)
+				expectManifest()
+				mock.ExpectRollback()
+			},
+			check: func(m *dto.Manifest, counts []*md.ProductCount) (*dto.DiscrepancyReport, error) {
+				require.True(t, m.Strict)
+				require.Equal(t, []*md.ProductCount{{Type: "обувь", Quantity: 1}}, counts)
+				return nil, errors.New("discrepancies")
+			},
+			wantErr:    errors.New("discrepancies"),
+			wantNilRes: true,
 		},
 		{
 			name: "ReceptionAlreadyClosed (no rows)",
@@ -435,8 +661,8 @@ func TestRepository_CloseLastReception(t *testing.T) {
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(closeReception)).
-					WithArgs(testReception.ID.Value.String()).
+				mock.ExpectQuery(regexp.QuoteMeta(closeReception)).
+					WithArgs(testReception.ID.Value.String(), uid).
 					WillReturnError(errors.New("exec error"))
 				mock.ExpectRollback()
 			},
@@ -459,9 +685,10 @@ func TestRepository_CloseLastReception(t *testing.T) {
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(closeReception)).
-					WithArgs(testReception.ID.Value.String()).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(closeReception)).
+					WithArgs(testReception.ID.Value.String(), uid).
+					WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("closed"))
+				expectAudit(mock, md.AuditReceptionClosed)
 
 				mock.ExpectCommit().WillReturnError(errors.New("commit error"))
 			},
@@ -474,7 +701,7 @@ func TestRepository_CloseLastReception(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				res, err := repo.CloseLastReception(ctx, receptionID)
+				res, err := repo.CloseLastReception(ctx, receptionID, uid, tt.check)
 
 				if tt.wantErr != nil {
 					require.Error(t, err)
@@ -488,6 +715,8 @@ func TestRepository_CloseLastReception(t *testing.T) {
 				} else {
 					require.NotNil(t, res)
 					require.Equal(t, testReception.ID, res.ID)
+					require.Equal(t, dto.ReceptionStatus("closed"), res.Status)
+					require.Equal(t, tt.wantReport, res.Discrepancies.Set)
 				}
 
 				require.NoError(t, mock.ExpectationsWereMet())
@@ -496,6 +725,230 @@ func TestRepository_CloseLastReception(t *testing.T) {
 	}
 }
 
+func TestRepository_CloseIdleReceptions(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	const reason = "no products added for 12h0m0s"
+	idleFor := 12 * time.Hour
+	columns := []string{"id", "status", "created_at", "pickup_point_id", "created_by"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(closeIdleReceptions)).
+		WithArgs(idleFor.Seconds(), reason, 10).
+		WillReturnError(errors.New("db error"))
+	mock.ExpectRollback()
+
+	res, err := repo.CloseIdleReceptions(ctx, idleFor, reason, 10)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	first, second := uuid.New(), uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(closeIdleReceptions)).
+		WithArgs(idleFor.Seconds(), reason, 10).
+		WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow(first.String(), "closed", time.Now().Add(-24*time.Hour), uuid.NewString(), uuid.NewString()).
+				AddRow(second.String(), "closed", time.Now().Add(-13*time.Hour), uuid.NewString(), nil),
+		)
+	expectAudit(mock, md.AuditReceptionAutoClosed)
+	expectAudit(mock, md.AuditReceptionAutoClosed)
+	mock.ExpectCommit()
+
+	res, err = repo.CloseIdleReceptions(ctx, idleFor, reason, 10)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, first, res[0].ID)
+	require.Equal(t, second, res[1].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CloseIdleReceptionsAfterReopen(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	const reason = "products were scanned into the wrong reception"
+	const idleReason = "no products added for 12h0m0s"
+	id, uid := uuid.New(), uuid.New()
+	window, idleFor := 24*time.Hour, 12*time.Hour
+
+	// The reception was created long before it was reopened, the tick must measure idleness from the reopen.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+		WithArgs(id.String(), window.Seconds()).
+		WillReturnRows(
+			sqlmock.NewRows(
+				[]string{
+					"id", "status", "created_at", "pickup_point_id", "created_by", "closed_by", "pvz_closed", "reopenable",
+					"superseded",
+				},
+			).AddRow(id.String(), "closed", time.Now().Add(-20*time.Hour), uuid.NewString(), nil, nil, false, true, false),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(reopenReception)).
+		WithArgs(id.String(), uid.String(), reason).
+		WillReturnRows(sqlmock.NewRows([]string{"reopened_at"}).AddRow(time.Now()))
+	expectAudit(mock, md.AuditReceptionReopened)
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`GREATEST\(r\.created_at, r\.reopened_at\) < NOW\(\) - make_interval\(secs => \$1\)`).
+		WithArgs(idleFor.Seconds(), idleReason, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at", "pickup_point_id", "created_by"}))
+	mock.ExpectCommit()
+
+	_, err = repo.ReopenReception(ctx, id, uid, reason, window)
+	require.NoError(t, err)
+
+	res, err := repo.CloseIdleReceptions(ctx, idleFor, idleReason, 10)
+	require.NoError(t, err)
+	require.Empty(t, res)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReopenReception(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	const reason = "products were scanned into the wrong reception"
+	id, uid, pvzID := uuid.New(), uuid.New(), uuid.New()
+	window := 24 * time.Hour
+	columns := []string{
+		"id", "status", "created_at", "pickup_point_id", "created_by", "closed_by", "pvz_closed", "reopenable", "superseded",
+	}
+	receptionRow := func(status string, reopenable, superseded bool) *sqlmock.Rows {
+		return sqlmock.NewRows(columns).
+			AddRow(id.String(), status, time.Now(), pvzID.String(), uuid.NewString(), uuid.NewString(), false, reopenable, superseded)
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(receptionRow("closed", true, false))
+				mock.ExpectQuery(regexp.QuoteMeta(reopenReception)).
+					WithArgs(id.String(), uid.String(), reason).
+					WillReturnRows(sqlmock.NewRows([]string{"reopened_at"}).AddRow(time.Now()))
+				expectAudit(mock, md.AuditReceptionReopened)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Not found",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "Still open",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(receptionRow("in_progress", false, false))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrReceptionStillOpen,
+		},
+		{
+			name: "PVZ closed",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(
+						sqlmock.NewRows(columns).
+							AddRow(id.String(), "closed", time.Now(), pvzID.String(), nil, nil, true, true, false),
+					)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrPVZClosed,
+		},
+		{
+			name: "Newer reception exists",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(receptionRow("closed", true, true))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNewerReceptionExists,
+		},
+		{
+			name: "Window expired",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(receptionRow("closed", false, false))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrReopenWindowExpired,
+		},
+		{
+			name: "Another reception opened concurrently",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForReopen)).
+					WithArgs(id.String(), window.Seconds()).
+					WillReturnRows(receptionRow("closed", true, false))
+				mock.ExpectQuery(regexp.QuoteMeta(reopenReception)).
+					WithArgs(id.String(), uid.String(), reason).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNewerReceptionExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.ReopenReception(ctx, id, uid, reason, window)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, dto.ReceptionStatusInProgress, res.Status)
+					require.Equal(t, reason, res.ReopenReason.Value)
+					require.Equal(t, uid, res.ReopenedBy.Value)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
 func TestRepository_DeleteLastProduct(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -505,6 +958,7 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 	repo := Repository{conn: db}
 	ctx := context.Background()
 
+	uid := uuid.New()
 	receptionID := uuid.New()
 	testReception := md.Reception{
 		ID:       receptionID,
@@ -512,6 +966,11 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 		PVZID:    uuid.New(),
 		Status:   "open",
 	}
+	productColumns := []string{"id", "reception_id", "type", "created_at", "created_by", "deleted_at", "deleted_by"}
+	deletedRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(productColumns).
+			AddRow(uuid.NewString(), receptionID.String(), "обувь", time.Now(), nil, time.Now(), uid.String())
+	}
 
 	tests := []struct {
 		name    string
@@ -529,13 +988,14 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 						testReception.PVZID.String(),
 						testReception.Status,
 					)
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(deleteLastProduct)).
-					WithArgs(testReception.ID).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(deleteLastProduct)).
+					WithArgs(testReception.ID, uid.String()).
+					WillReturnRows(deletedRows())
+				expectAudit(mock, md.AuditProductDeleted)
 
 				mock.ExpectCommit()
 			},
@@ -545,7 +1005,7 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 			name: "NoActiveReception",
 			setup: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnError(sql.ErrNoRows)
 				mock.ExpectRollback()
@@ -564,13 +1024,13 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 						testReception.PVZID.String(),
 						testReception.Status,
 					)
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(deleteLastProduct)).
-					WithArgs(testReception.ID).
-					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(regexp.QuoteMeta(deleteLastProduct)).
+					WithArgs(testReception.ID, uid.String()).
+					WillReturnRows(sqlmock.NewRows(productColumns))
 
 				mock.ExpectRollback()
 			},
@@ -580,7 +1040,7 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 			name: "GetContextError",
 			setup: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnError(errors.New("db get error"))
 				mock.ExpectRollback()
@@ -599,12 +1059,12 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 						testReception.PVZID.String(),
 						testReception.Status,
 					)
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(deleteLastProduct)).
-					WithArgs(testReception.ID).
+				mock.ExpectQuery(regexp.QuoteMeta(deleteLastProduct)).
+					WithArgs(testReception.ID, uid.String()).
 					WillReturnError(errors.New("exec error"))
 
 				mock.ExpectRollback()
@@ -622,13 +1082,14 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 						testReception.PVZID.String(),
 						testReception.Status,
 					)
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(receptionID).
 					WillReturnRows(rows)
 
-				mock.ExpectExec(regexp.QuoteMeta(deleteLastProduct)).
-					WithArgs(testReception.ID).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(deleteLastProduct)).
+					WithArgs(testReception.ID, uid.String()).
+					WillReturnRows(deletedRows())
+				expectAudit(mock, md.AuditProductDeleted)
 
 				mock.ExpectCommit().WillReturnError(errors.New("commit error"))
 			},
@@ -640,13 +1101,16 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				err := repo.DeleteLastProduct(ctx, receptionID)
+				res, err := repo.DeleteLastProduct(ctx, receptionID, uid)
 
 				if tt.wantErr != nil {
 					require.Error(t, err)
 					require.ErrorContains(t, err, tt.wantErr.Error())
+					require.Nil(t, res)
 				} else {
 					require.NoError(t, err)
+					require.Equal(t, receptionID, res.ReceptionId)
+					require.True(t, res.DeletedAt.Set)
 				}
 
 				require.NoError(t, mock.ExpectationsWereMet())
@@ -655,7 +1119,7 @@ func TestRepository_DeleteLastProduct(t *testing.T) {
 	}
 }
 
-func TestRepository_CreateReception(t *testing.T) {
+func TestRepository_DeleteProduct(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err)
 	defer mockDB.Close()
@@ -664,77 +1128,258 @@ func TestRepository_CreateReception(t *testing.T) {
 	repo := Repository{conn: db}
 	ctx := context.Background()
 
-	req := &dto.ReceptionsPostReq{
-		PvzId: uuid.New(),
-	}
-	testResponse := dto.Reception{
-		ID: dto.OptUUID{
-			Value: uuid.New(),
-			Set:   true,
-		},
-		PvzId:    req.PvzId,
-		Status:   "open",
-		DateTime: time.Now(),
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	receptionColumns := []string{"id", "status", "created_at", "pickup_point_id", "created_by"}
+	productColumns := []string{"id", "reception_id", "type", "created_at", "created_by", "deleted_at", "deleted_by"}
+	expectReception := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(findReceptionForUpdate)).
+			WithArgs(receptionID).
+			WillReturnRows(
+				sqlmock.NewRows(receptionColumns).
+					AddRow(receptionID.String(), "in_progress", time.Now(), pvzID.String(), nil),
+			)
 	}
 
 	tests := []struct {
-		name       string
-		setup      func()
-		wantErr    error
-		wantResult *dto.Reception
+		name    string
+		setup   func()
+		wantErr error
 	}{
 		{
-			name: "Success",
+			name: "ReceptionIsNotInProgress",
 			setup: func() {
 				mock.ExpectBegin()
-
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
-					WithArgs(req.PvzId).
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForUpdate)).
+					WithArgs(receptionID).
 					WillReturnError(sql.ErrNoRows)
-
-				mock.ExpectQuery(regexp.QuoteMeta(createReception)).
-					WithArgs(req.PvzId).
-					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at"}).
-							AddRow(
-								testResponse.ID.Value.String(),
-								req.PvzId.String(),
-								testResponse.Status,
-								testResponse.DateTime,
-							),
-					)
-
-				mock.ExpectCommit()
+				mock.ExpectRollback()
 			},
-			wantErr:    nil,
-			wantResult: &testResponse,
+			wantErr: repo2.ErrNoActiveReception,
 		},
 		{
-			name: "ReceptionAlreadyOpen",
+			name: "ProductNotFound",
 			setup: func() {
 				mock.ExpectBegin()
-
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
-					WithArgs(req.PvzId).
-					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at"}).
-							AddRow(
-								testResponse.ID.Value.String(),
-								req.PvzId.String(),
-								"in_progress",
-								testResponse.DateTime,
-							),
-					)
-
+				expectReception()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteProduct)).
+					WithArgs(receptionID, productID, uid.String()).
+					WillReturnRows(sqlmock.NewRows(productColumns))
 				mock.ExpectRollback()
 			},
-			wantErr:    repo2.ErrReceptionStillOpen,
-			wantResult: nil,
+			wantErr: repo2.ErrNotFound,
 		},
 		{
-			name: "FindLastReceptionError",
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				expectReception()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteProduct)).
+					WithArgs(receptionID, productID, uid.String()).
+					WillReturnRows(
+						sqlmock.NewRows(productColumns).
+							AddRow(productID.String(), receptionID.String(), "обувь", time.Now(), nil, time.Now(), uid.String()),
+					)
+				expectAudit(mock, md.AuditProductDeleted)
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				err := repo.DeleteProduct(ctx, receptionID, productID, uid)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_UndoLastDelete(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	receptionColumns := []string{"id", "created_at", "pickup_point_id", "status"}
+	productColumns := []string{"id", "reception_id", "type", "created_at", "created_by", "deleted_at", "deleted_by"}
+	expectReception := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+			WithArgs(pvzID).
+			WillReturnRows(
+				sqlmock.NewRows(receptionColumns).
+					AddRow(receptionID.String(), time.Now(), pvzID.String(), "in_progress"),
+			)
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "NoActiveReception",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(pvzID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNoActiveReception,
+		},
+		{
+			name: "NoDeletedItems",
+			setup: func() {
+				mock.ExpectBegin()
+				expectReception()
+				mock.ExpectQuery(regexp.QuoteMeta(undoLastDelete)).
+					WithArgs(receptionID).
+					WillReturnRows(sqlmock.NewRows(productColumns))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNoDeletedItems,
+		},
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				expectReception()
+				mock.ExpectQuery(regexp.QuoteMeta(undoLastDelete)).
+					WithArgs(receptionID).
+					WillReturnRows(
+						sqlmock.NewRows(productColumns).
+							AddRow(productID.String(), receptionID.String(), "обувь", time.Now(), nil, nil, nil),
+					)
+				expectAudit(mock, md.AuditProductRestored)
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.UndoLastDelete(ctx, pvzID)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, productID, res.ID.Value)
+					require.False(t, res.DeletedAt.Set)
+				}
+
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreateReception(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	req := &dto.ReceptionsPostReq{
+		PvzId: uuid.New(),
+	}
+	testResponse := dto.Reception{
+		ID: dto.OptUUID{
+			Value: uuid.New(),
+			Set:   true,
+		},
+		PvzId:     req.PvzId,
+		Status:    "open",
+		DateTime:  time.Now(),
+		CreatedBy: dto.NewOptUUID(uid),
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		wantErr    error
+		wantResult *dto.Reception
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
+
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(req.PvzId).
+					WillReturnError(sql.ErrNoRows)
+
+				mock.ExpectQuery(regexp.QuoteMeta(createReception)).
+					WithArgs(req.PvzId, uid.String()).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at", "created_by"}).
+							AddRow(
+								testResponse.ID.Value.String(),
+								req.PvzId.String(),
+								testResponse.Status,
+								testResponse.DateTime,
+								uid.String(),
+							),
+					)
+				expectAudit(mock, md.AuditReceptionOpened)
+
+				mock.ExpectCommit()
+			},
+			wantErr:    nil,
+			wantResult: &testResponse,
+		},
+		{
+			name: "ReceptionAlreadyOpen",
+			setup: func() {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
+
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(req.PvzId).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at"}).
+							AddRow(
+								testResponse.ID.Value.String(),
+								req.PvzId.String(),
+								"in_progress",
+								testResponse.DateTime,
+							),
+					)
+
+				mock.ExpectRollback()
+			},
+			wantErr:    repo2.ErrReceptionStillOpen,
+			wantResult: nil,
+		},
+		{
+			name: "FindLastReceptionError",
 			setup: func() {
 				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
 
 				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(req.PvzId).
@@ -745,17 +1390,40 @@ func TestRepository_CreateReception(t *testing.T) {
 			wantErr:    errors.New("db query error"),
 			wantResult: nil,
 		},
+		{
+			name: "PVZNotFound",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZStatusForShare)).
+					WithArgs(req.PvzId).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr:    repo2.ErrNotFound,
+			wantResult: nil,
+		},
+		{
+			name: "PVZClosed",
+			setup: func() {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusClosed)
+				mock.ExpectRollback()
+			},
+			wantErr:    repo2.ErrPVZClosed,
+			wantResult: nil,
+		},
 		{
 			name: "CreateReceptionError",
 			setup: func() {
 				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
 
 				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(req.PvzId).
 					WillReturnError(sql.ErrNoRows)
 
 				mock.ExpectQuery(regexp.QuoteMeta(createReception)).
-					WithArgs(req.PvzId).
+					WithArgs(req.PvzId, uid.String()).
 					WillReturnError(errors.New("db create error"))
 
 				mock.ExpectRollback()
@@ -767,22 +1435,25 @@ func TestRepository_CreateReception(t *testing.T) {
 			name: "CommitError",
 			setup: func() {
 				mock.ExpectBegin()
+				expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
 
 				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(req.PvzId).
 					WillReturnError(sql.ErrNoRows)
 
 				mock.ExpectQuery(regexp.QuoteMeta(createReception)).
-					WithArgs(req.PvzId).
+					WithArgs(req.PvzId, uid.String()).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at"}).
+						sqlmock.NewRows([]string{"id", "pickup_point_id", "status", "created_at", "created_by"}).
 							AddRow(
 								testResponse.ID.Value.String(),
 								req.PvzId.String(),
 								testResponse.Status,
 								testResponse.DateTime,
+								uid.String(),
 							),
 					)
+				expectAudit(mock, md.AuditReceptionOpened)
 
 				mock.ExpectCommit().WillReturnError(errors.New("commit error"))
 			},
@@ -795,7 +1466,7 @@ func TestRepository_CreateReception(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				res, err := repo.CreateReception(ctx, req)
+				res, err := repo.CreateReception(ctx, req, uid)
 
 				if tt.wantErr != nil {
 					require.Error(t, err)
@@ -827,6 +1498,7 @@ func TestRepository_AddItemToReception(t *testing.T) {
 
 	pvzID := uuid.New()
 	receptionID := uuid.New()
+	uid := uuid.New()
 
 	req := &dto.ProductsPostReq{
 		PvzId: pvzID,
@@ -844,12 +1516,14 @@ func TestRepository_AddItemToReception(t *testing.T) {
 			Value: uuid.New(),
 			Set:   true,
 		},
-		Type:        dto.ProductType(req.Type),
+		Type:        req.Type,
 		ReceptionId: receptionID,
 		DateTime: dto.OptDateTime{
 			Value: time.Now(),
 			Set:   true,
 		},
+		CreatedBy: dto.NewOptUUID(uid),
+		Quantity:  dto.NewOptInt(1),
 	}
 
 	tests := []struct {
@@ -861,7 +1535,8 @@ func TestRepository_AddItemToReception(t *testing.T) {
 		{
 			name: "Success",
 			setup: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(pvzID).
 					WillReturnRows(
 						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
@@ -874,16 +1549,20 @@ func TestRepository_AddItemToReception(t *testing.T) {
 					)
 
 				mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
-					WithArgs(receptionID, req.Type).
+					WithArgs(receptionID, req.Type, uid.String(), nil, nil, nil, 1, nil).
 					WillReturnRows(
-						sqlmock.NewRows([]string{"id", "type", "reception_id", "created_at"}).
+						sqlmock.NewRows([]string{"id", "type", "reception_id", "created_at", "created_by", "quantity"}).
 							AddRow(
 								testResp.ID.Value.String(),
 								testResp.Type,
 								testResp.ReceptionId.String(),
 								testResp.DateTime.Value,
+								uid.String(),
+								1,
 							),
 					)
+				expectAudit(mock, md.AuditProductAdded)
+				mock.ExpectCommit()
 			},
 			wantErr:    nil,
 			wantResult: &testResp,
@@ -891,9 +1570,11 @@ func TestRepository_AddItemToReception(t *testing.T) {
 		{
 			name: "No Active Reception",
 			setup: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(pvzID).
 					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
 			},
 			wantErr:    repo2.ErrNoActiveReception,
 			wantResult: nil,
@@ -901,9 +1582,11 @@ func TestRepository_AddItemToReception(t *testing.T) {
 		{
 			name: "Find Reception DB Error",
 			setup: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(pvzID).
 					WillReturnError(errors.New("db error"))
+				mock.ExpectRollback()
 			},
 			wantErr:    errors.New("db error"),
 			wantResult: nil,
@@ -911,7 +1594,8 @@ func TestRepository_AddItemToReception(t *testing.T) {
 		{
 			name: "Invalid Type Error",
 			setup: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(pvzID).
 					WillReturnRows(
 						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
@@ -924,16 +1608,48 @@ func TestRepository_AddItemToReception(t *testing.T) {
 					)
 
 				mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
-					WithArgs(receptionID, req.Type).
-					WillReturnError(&pgconn.PgError{Code: "22P02"})
+					WithArgs(receptionID, req.Type, uid.String(), nil, nil, nil, 1, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "reception_id", "type", "created_at"}))
+				mock.ExpectQuery(regexp.QuoteMeta(isProductTypeActive)).
+					WithArgs(req.Type).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectRollback()
 			},
 			wantErr:    repo2.ErrTypeIsNotValid,
 			wantResult: nil,
 		},
+		{
+			name: "Duplicate Barcode",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+					WithArgs(pvzID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
+							AddRow(
+								testReception.ID.String(),
+								testReception.DateTime,
+								testReception.PVZID.String(),
+								testReception.Status,
+							),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
+					WithArgs(receptionID, req.Type, uid.String(), nil, nil, nil, 1, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "reception_id", "type", "created_at"}))
+				mock.ExpectQuery(regexp.QuoteMeta(isProductTypeActive)).
+					WithArgs(req.Type).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr:    repo2.ErrAlreadyExists,
+			wantResult: nil,
+		},
 		{
 			name: "Insert Item DB Error",
 			setup: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(findLastReception)).
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
 					WithArgs(pvzID).
 					WillReturnRows(
 						sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
@@ -946,8 +1662,9 @@ func TestRepository_AddItemToReception(t *testing.T) {
 					)
 
 				mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
-					WithArgs(receptionID, req.Type).
+					WithArgs(receptionID, req.Type, uid.String(), nil, nil, nil, 1, nil).
 					WillReturnError(errors.New("insert error"))
+				mock.ExpectRollback()
 			},
 			wantErr:    errors.New("insert error"),
 			wantResult: nil,
@@ -958,7 +1675,7 @@ func TestRepository_AddItemToReception(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				res, err := repo.AddItemToReception(ctx, req)
+				res, err := repo.AddItemToReception(ctx, req, uid)
 
 				if tt.wantErr != nil {
 					require.Error(t, err)
@@ -979,6 +1696,150 @@ func TestRepository_AddItemToReception(t *testing.T) {
 	}
 }
 
+func TestRepository_AddItemsToReception(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	scannedAt := time.Now().Add(-time.Hour)
+	items := []dto.ProductBatchItem{
+		{Type: "обувь", ScannedAt: dto.NewOptDateTime(scannedAt)},
+		{Type: "unknown"},
+		{Type: "одежда"},
+	}
+	receptionColumns := []string{"id", "created_at", "pickup_point_id", "status"}
+	productColumns := []string{"id", "reception_id", "type", "created_at", "scanned_at", "created_by"}
+	expectReception := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+			WithArgs(pvzID).
+			WillReturnRows(
+				sqlmock.NewRows(receptionColumns).
+					AddRow(receptionID.String(), time.Now(), pvzID.String(), "in_progress"),
+			)
+	}
+
+	t.Run(
+		"NoActiveReception", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+				WithArgs(pvzID).
+				WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+
+			res, errs, err := repo.AddItemsToReception(ctx, pvzID, items, uid)
+			require.ErrorIs(t, err, repo2.ErrNoActiveReception)
+			require.Nil(t, res)
+			require.Nil(t, errs)
+			require.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"InsertError", func(t *testing.T) {
+			mock.ExpectBegin()
+			expectReception()
+			mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
+				WithArgs(receptionID, "обувь", uid.String(), scannedAt, nil, nil, 1, nil).
+				WillReturnError(errors.New("insert error"))
+			mock.ExpectRollback()
+
+			res, _, err := repo.AddItemsToReception(ctx, pvzID, items, uid)
+			require.ErrorContains(t, err, "insert error")
+			require.Nil(t, res)
+			require.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+
+	t.Run(
+		"InvalidTypeDoesNotAbortBatch", func(t *testing.T) {
+			mock.ExpectBegin()
+			expectReception()
+			mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
+				WithArgs(receptionID, "обувь", uid.String(), scannedAt, nil, nil, 1, nil).
+				WillReturnRows(
+					sqlmock.NewRows(productColumns).
+						AddRow(uuid.NewString(), receptionID.String(), "обувь", time.Now(), scannedAt, uid.String()),
+				)
+			expectAudit(mock, md.AuditProductAdded)
+			mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
+				WithArgs(receptionID, "unknown", uid.String(), nil, nil, nil, 1, nil).
+				WillReturnRows(sqlmock.NewRows(productColumns))
+			mock.ExpectQuery(regexp.QuoteMeta(isProductTypeActive)).
+				WithArgs("unknown").
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectQuery(regexp.QuoteMeta(addItemToReception)).
+				WithArgs(receptionID, "одежда", uid.String(), nil, nil, nil, 1, nil).
+				WillReturnRows(
+					sqlmock.NewRows(productColumns).
+						AddRow(uuid.NewString(), receptionID.String(), "одежда", time.Now(), nil, uid.String()),
+				)
+			expectAudit(mock, md.AuditProductAdded)
+			mock.ExpectCommit()
+
+			res, errs, err := repo.AddItemsToReception(ctx, pvzID, items, uid)
+			require.NoError(t, err)
+			require.Len(t, res, 3)
+			require.Equal(t, scannedAt, res[0].ScannedAt.Value)
+			require.NotEqual(t, scannedAt, res[0].DateTime.Value)
+			require.Nil(t, res[1])
+			require.False(t, res[2].ScannedAt.Set)
+			require.ErrorIs(t, errs[1], repo2.ErrTypeIsNotValid)
+			require.Equal(t, "одежда", res[2].Type)
+			require.NoError(t, errs[2])
+			require.NoError(t, mock.ExpectationsWereMet())
+		},
+	)
+}
+
+func TestRepository_FindProductsByBarcode(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	const barcode = "4006381333931"
+	columns := []string{"id", "reception_id", "type", "created_at", "created_by", "barcode", "sku", "quantity", "weight"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(findProductsByBarcode)).
+		WithArgs(barcode).
+		WillReturnError(errors.New("db error"))
+
+	res, err := repo.FindProductsByBarcode(ctx, barcode)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	first, second := uuid.New(), uuid.New()
+	mock.ExpectQuery(regexp.QuoteMeta(findProductsByBarcode)).
+		WithArgs(barcode).
+		WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow(uuid.NewString(), first.String(), "обувь", time.Now(), nil, barcode, "SKU-1", 3, "0.500").
+				AddRow(uuid.NewString(), second.String(), "обувь", time.Now(), nil, barcode, nil, 1, nil),
+		)
+
+	res, err = repo.FindProductsByBarcode(ctx, barcode)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, first, res[0].ReceptionId)
+	require.Equal(t, dto.NewOptString(barcode), res[0].Barcode)
+	require.Equal(t, dto.NewOptString("SKU-1"), res[0].Sku)
+	require.Equal(t, dto.NewOptInt(3), res[0].Quantity)
+	require.Equal(t, dto.NewOptFloat64(0.5), res[0].Weight)
+	require.False(t, res[1].Sku.Set)
+	require.False(t, res[1].Weight.Set)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_GetPVZList(t *testing.T) {
 	mockDB, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -992,6 +1853,10 @@ func TestRepository_GetPVZList(t *testing.T) {
 		ID:               uuid.New(),
 		City:             "Moscow",
 		RegistrationDate: time.Now(),
+		Timezone:         sql.NullString{String: "Europe/Moscow", Valid: true},
+		Latitude:         sql.NullFloat64{Float64: 55.7658, Valid: true},
+		Longitude:        sql.NullFloat64{Float64: 37.6049, Valid: true},
+		Capacity:         sql.NullInt64{Int64: 500, Valid: true},
 	}
 
 	tests := []struct {
@@ -1003,8 +1868,8 @@ func TestRepository_GetPVZList(t *testing.T) {
 		{
 			name: "Success",
 			setup: func() {
-				rows := sqlmock.NewRows([]string{"id", "city", "created_at"}).
-					AddRow(testPVZ.ID.String(), testPVZ.City, testPVZ.RegistrationDate)
+				rows := sqlmock.NewRows([]string{"id", "city", "created_at", "timezone", "latitude", "longitude", "capacity"}).
+					AddRow(testPVZ.ID.String(), testPVZ.City, testPVZ.RegistrationDate, "Europe/Moscow", 55.7658, 37.6049, 500)
 
 				mock.ExpectQuery(regexp.QuoteMeta(listPVZs)).
 					WillReturnRows(rows)
@@ -1037,7 +1902,7 @@ func TestRepository_GetPVZList(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				tt.setup()
-				res, err := repo.GetPVZList(ctx)
+				res, err := repo.GetPVZList(ctx, nil, 0)
 
 				if tt.wantErr != nil {
 					require.Error(t, err)
@@ -1052,3 +1917,1397 @@ func TestRepository_GetPVZList(t *testing.T) {
 		)
 	}
 }
+
+func TestRepository_GetPVZCity(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	testID := uuid.New()
+	testErr := errors.New("db error")
+
+	tests := []struct {
+		name     string
+		setup    func()
+		wantErr  error
+		wantCity string
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(getPVZCity)).
+					WithArgs(testID).
+					WillReturnRows(sqlmock.NewRows([]string{"city"}).AddRow("Москва"))
+			},
+			wantCity: "Москва",
+		},
+		{
+			name: "NotFound",
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(getPVZCity)).
+					WithArgs(testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "DB Error",
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(getPVZCity)).
+					WithArgs(testID).
+					WillReturnError(testErr)
+			},
+			wantErr: testErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				city, err := repo.GetPVZCity(ctx, testID)
+
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+				require.Equal(t, tt.wantCity, city)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_FindNearbyPVZs(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	now := time.Now()
+	near, far := uuid.New(), uuid.New()
+	cols := []string{
+		"id", "city", "created_at", "name", "address", "working_hours",
+		"timezone", "latitude", "longitude", "capacity", "status", "closed_at", "distance",
+	}
+
+	tests := []struct {
+		name       string
+		after      *md.NearbyCursor
+		limit      int64
+		setup      func()
+		wantErr    error
+		assertions func(res []*dto.NearbyPVZ)
+	}{
+		{
+			name:  "Success",
+			limit: 20,
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(findNearbyPVZs)).
+					WithArgs(55.7558, 37.6173, 3000.0, 20, nil, nil).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(
+								near.String(), "Москва", now, "ПВЗ на Тверской", nil, nil,
+								"Europe/Moscow", 55.7658, 37.6049, 500, md.PVZStatusActive, nil, 1312.4,
+							).
+							AddRow(
+								far.String(), "Москва", now, nil, nil, nil,
+								nil, 55.7312, 37.5902, nil, md.PVZStatusActive, nil, 2941.7,
+							),
+					)
+			},
+			assertions: func(res []*dto.NearbyPVZ) {
+				require.Len(t, res, 2)
+				require.Equal(t, near, res[0].Pvz.ID.Value)
+				require.Equal(t, 1312.4, res[0].Distance)
+				require.Equal(t, 55.7658, res[0].Pvz.Coordinates.Value.Latitude)
+				require.Equal(t, "Europe/Moscow", res[0].Pvz.Timezone.Value)
+				require.Equal(t, far, res[1].Pvz.ID.Value)
+				require.False(t, res[1].Pvz.Timezone.Set)
+			},
+		},
+		{
+			name:  "After cursor",
+			after: &md.NearbyCursor{Distance: 2941.7, ID: far},
+			limit: 100,
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(findNearbyPVZs)).
+					WithArgs(55.7558, 37.6173, 3000.0, 100, 2941.7, far.String()).
+					WillReturnRows(sqlmock.NewRows(cols))
+			},
+			assertions: func(res []*dto.NearbyPVZ) {
+				require.NotNil(t, res)
+				require.Empty(t, res)
+			},
+		},
+		{
+			name:  "DB error",
+			limit: 20,
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(findNearbyPVZs)).
+					WithArgs(55.7558, 37.6173, 3000.0, 20, nil, nil).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.FindNearbyPVZs(ctx, 55.7558, 37.6173, 3000, tt.after, tt.limit)
+				if tt.wantErr != nil {
+					require.EqualError(t, err, tt.wantErr.Error())
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					tt.assertions(res)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_UpdatePVZ(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	id := uuid.New()
+	now := time.Now()
+	cols := []string{
+		"id", "city", "created_at", "name", "address", "working_hours",
+		"timezone", "latitude", "longitude", "capacity", "status", "closed_at",
+	}
+	hours := `[{"day":"mon","open":"09:00","close":"21:00"}]`
+	req := &dto.PVZUpdate{
+		City:         dto.NewOptString("Казань"),
+		Name:         dto.NewOptString("ПВЗ на Баумана"),
+		WorkingHours: dto.WorkingHours{{Day: dto.WorkingHoursItemDayMon, Open: "09:00", Close: "21:00"}},
+		Coordinates:  dto.NewOptCoordinates(dto.Coordinates{Latitude: 55.7887, Longitude: 49.1221}),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(id.String(), "Москва", now, nil, nil, nil, "Europe/Moscow", nil, nil, nil, md.PVZStatusActive, nil),
+					)
+				mock.ExpectQuery(regexp.QuoteMeta(isCityActive)).
+					WithArgs("Казань").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectQuery(regexp.QuoteMeta(updatePVZ)).
+					WithArgs(
+						id, "Казань", "ПВЗ на Баумана", nil, md.WorkingHours{{Day: "mon", Open: "09:00", Close: "21:00"}},
+						nil, 55.7887, 49.1221, nil,
+					).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(
+								id.String(), "Казань", now, "ПВЗ на Баумана", nil, hours,
+								"Europe/Moscow", 55.7887, 49.1221, 300, md.PVZStatusActive, nil,
+							),
+					)
+				expectAudit(mock, md.AuditPVZUpdated)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Not found",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "PVZ closed",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusClosed, now))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrPVZClosed,
+		},
+		{
+			name: "Timezone required",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusActive, nil))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrTimezoneRequired,
+		},
+		{
+			name: "City is not active",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(
+						sqlmock.NewRows(cols).
+							AddRow(id.String(), "Москва", now, nil, nil, nil, "Europe/Moscow", nil, nil, nil, md.PVZStatusActive, nil),
+					)
+				mock.ExpectQuery(regexp.QuoteMeta(isCityActive)).
+					WithArgs("Казань").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrCityIsNotValid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.UpdatePVZ(ctx, id, req)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, "Казань", string(res.City))
+					require.Equal(t, "ПВЗ на Баумана", res.Name.Value)
+					require.False(t, res.Address.Set)
+					require.Len(t, res.WorkingHours, 1)
+					require.Equal(t, dto.PVZStatusActive, res.Status.Value)
+					require.Equal(t, 49.1221, res.Coordinates.Value.Longitude)
+					require.Equal(t, "Europe/Moscow", res.Timezone.Value)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_DecommissionPVZ(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	id := uuid.New()
+	uid := uuid.New()
+	now := time.Now()
+	cols := []string{
+		"id", "city", "created_at", "name", "address", "working_hours",
+		"timezone", "latitude", "longitude", "capacity", "status", "closed_at",
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusActive, nil))
+				mock.ExpectQuery(regexp.QuoteMeta(hasOpenReception)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectQuery(regexp.QuoteMeta(decommissionPVZ)).
+					WithArgs(id, uid.String()).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusClosed, now))
+				expectAudit(mock, md.AuditPVZDecommissioned)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Not found",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "Already closed",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusClosed, now))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrPVZClosed,
+		},
+		{
+			name: "Reception still open",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findPVZForUpdate)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(cols).AddRow(id.String(), "Москва", now, nil, nil, nil, nil, nil, nil, nil, md.PVZStatusActive, nil))
+				mock.ExpectQuery(regexp.QuoteMeta(hasOpenReception)).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrReceptionStillOpen,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.DecommissionPVZ(ctx, id, uid)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, dto.PVZStatusClosed, res.Status.Value)
+					require.True(t, res.ClosedAt.Set)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_GetReceptionSummary(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	receptionID := uuid.New()
+	pvzID := uuid.New()
+	uid := uuid.New()
+	employee := uuid.New()
+	openedAt := time.Now().Add(-time.Hour)
+	closedAt := time.Now()
+	columns := []string{
+		"id", "pickup_point_id", "status", "created_at", "created_by", "closed_at", "closed_by", "auto_closed",
+		"close_reason", "type", "products", "first_product_at", "last_product_at",
+	}
+
+	tests := []struct {
+		name       string
+		setup      func()
+		wantErr    error
+		assertions func(res *dto.ReceptionSummary)
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(
+						receptionID.String(), pvzID.String(), "closed", openedAt, employee.String(), closedAt, uid.String(), false, nil,
+						"обувь", 2, openedAt.Add(10*time.Minute), openedAt.Add(20*time.Minute),
+					).
+					AddRow(
+						receptionID.String(), pvzID.String(), "closed", openedAt, employee.String(), closedAt, uid.String(), false, nil,
+						"электроника", 3, openedAt.Add(5*time.Minute), openedAt.Add(15*time.Minute),
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(getReceptionSummary)).
+					WithArgs(receptionID).
+					WillReturnRows(rows)
+			},
+			assertions: func(res *dto.ReceptionSummary) {
+				require.Equal(t, receptionID, res.ReceptionId)
+				require.Equal(t, int64(5), res.TotalProducts)
+				require.Len(t, res.ProductCounts, 2)
+				require.Equal(t, openedAt.Add(5*time.Minute), res.FirstProductAt.Value)
+				require.Equal(t, openedAt.Add(20*time.Minute), res.LastProductAt.Value)
+				require.Equal(t, int64(closedAt.Sub(openedAt).Seconds()), res.DurationSeconds.Value)
+				require.Equal(t, employee, res.CreatedBy.Value)
+				require.Equal(t, uid, res.ClosedBy.Value)
+				require.False(t, res.AutoClosed.Value)
+				require.False(t, res.CloseReason.Set)
+			},
+		},
+		{
+			name: "Auto closed",
+			setup: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(
+						receptionID.String(), pvzID.String(), "closed", openedAt, nil, closedAt, nil, true, "no products added for 12h0m0s",
+						nil, 0, nil, nil,
+					)
+
+				mock.ExpectQuery(regexp.QuoteMeta(getReceptionSummary)).
+					WithArgs(receptionID).
+					WillReturnRows(rows)
+			},
+			assertions: func(res *dto.ReceptionSummary) {
+				require.False(t, res.CreatedBy.Set)
+				require.False(t, res.ClosedBy.Set)
+				require.True(t, res.AutoClosed.Value)
+				require.Equal(t, dto.NewOptString("no products added for 12h0m0s"), res.CloseReason)
+			},
+		},
+		{
+			name: "No products",
+			setup: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow(receptionID.String(), pvzID.String(), "in_progress", openedAt, nil, nil, nil, false, nil, nil, 0, nil, nil)
+
+				mock.ExpectQuery(regexp.QuoteMeta(getReceptionSummary)).
+					WithArgs(receptionID).
+					WillReturnRows(rows)
+			},
+			assertions: func(res *dto.ReceptionSummary) {
+				require.Equal(t, int64(0), res.TotalProducts)
+				require.Empty(t, res.ProductCounts)
+				require.False(t, res.FirstProductAt.Set)
+				require.False(t, res.ClosedAt.Set)
+				require.False(t, res.DurationSeconds.Set)
+				require.False(t, res.ClosedBy.Set)
+			},
+		},
+		{
+			name: "NotFound",
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(getReceptionSummary)).
+					WithArgs(receptionID).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "DB Error",
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(getReceptionSummary)).
+					WithArgs(receptionID).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.GetReceptionSummary(ctx, receptionID)
+
+				if tt.wantErr != nil {
+					require.Error(t, err)
+					require.ErrorContains(t, err, tt.wantErr.Error())
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					tt.assertions(res)
+				}
+
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_CreateCity(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	createdAt := time.Now()
+	tests := []struct {
+		name    string
+		req     *dto.DictionaryEntry
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success defaults to active",
+			req:  &dto.DictionaryEntry{Name: "Новосибирск"},
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(createCity)).
+					WithArgs("Новосибирск", true).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"name", "is_active", "created_at"}).
+							AddRow("Новосибирск", true, createdAt),
+					)
+				expectAudit(mock, md.AuditCityCreated)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Duplicate",
+			req:  &dto.DictionaryEntry{Name: "Москва", IsActive: dto.NewOptBool(false)},
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(createCity)).
+					WithArgs("Москва", false).
+					WillReturnError(&pgconn.PgError{Code: "23505"})
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.CreateCity(ctx, tt.req)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tt.req.Name, res.Name)
+					require.True(t, res.IsActive.Value)
+					require.Equal(t, createdAt, res.CreatedAt.Value)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_SetProductTypeActive(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	columns := []string{"name", "is_active", "created_at", "is_active"}
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(setProductTypeActive)).
+		WithArgs("unknown", false).
+		WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectRollback()
+
+	res, err := repo.SetProductTypeActive(ctx, "unknown", false)
+	require.ErrorIs(t, err, repo2.ErrNotFound)
+	require.Nil(t, res)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(setProductTypeActive)).
+		WithArgs("обувь", false).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("обувь", false, time.Now(), true))
+	mock.ExpectExec(regexp.QuoteMeta(insertAudit)).
+		WithArgs(
+			nil, nil, md.AuditProductTypeUpdated, nil, nil, nil, nil,
+			sqlmock.AnyArg(), sqlmock.AnyArg(),
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	res, err = repo.SetProductTypeActive(ctx, "обувь", false)
+	require.NoError(t, err)
+	require.False(t, res.IsActive.Value)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteCity(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteCity)).
+					WithArgs("Казань").
+					WillReturnRows(
+						sqlmock.NewRows([]string{"name", "is_active", "created_at"}).
+							AddRow("Казань", false, time.Now()),
+					)
+				expectAudit(mock, md.AuditCityDeleted)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Not found",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteCity)).
+					WithArgs("Казань").
+					WillReturnRows(sqlmock.NewRows([]string{"name", "is_active", "created_at"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+		{
+			name: "Referenced by PVZ",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(deleteCity)).
+					WithArgs("Казань").
+					WillReturnError(&pgconn.PgError{Code: "23503"})
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrInUse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				err := repo.DeleteCity(ctx, "Казань")
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+				} else {
+					require.NoError(t, err)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_ListCities(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta(listCities)).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"name", "is_active", "created_at"}).
+				AddRow("Казань", true, time.Now()).
+				AddRow("Москва", false, time.Now()),
+		)
+
+	res, err := repo.ListCities(ctx)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "Казань", res[0].Name)
+	require.False(t, res[1].IsActive.Value)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RotateRefreshToken(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(findRefreshTokenForUpdate)).
+		WithArgs("unknown").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "role", "expires_at"}))
+	mock.ExpectRollback()
+
+	res, err := repo.RotateRefreshToken(ctx, "unknown", "new", expiresAt)
+	require.ErrorIs(t, err, repo2.ErrNotFound)
+	require.Nil(t, res)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(findRefreshTokenForUpdate)).
+		WithArgs("old").
+		WillReturnRows(
+			sqlmock.NewRows([]string{"user_id", "role", "expires_at"}).
+				AddRow(uid.String(), md.EmployeeRole, time.Now()),
+		)
+	mock.ExpectExec(regexp.QuoteMeta(revokeRefreshToken)).
+		WithArgs("old", uid).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(createRefreshToken)).
+		WithArgs(uid, md.EmployeeRole, "new", expiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	res, err = repo.RotateRefreshToken(ctx, "old", "new", expiresAt)
+	require.NoError(t, err)
+	require.Equal(t, uid, res.UserID)
+	require.Equal(t, md.EmployeeRole, res.Role)
+	require.Equal(t, expiresAt, res.ExpiresAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_IsTokenRevoked(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta(isTokenRevoked)).
+		WithArgs("jti").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	revoked, err := repo.IsTokenRevoked(ctx, "jti")
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ClaimIdempotencyKey(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	k := &md.IdempotencyKey{
+		UserID:      uuid.New(),
+		Key:         "key",
+		RequestHash: "hash",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		affected int64
+		claimed  bool
+	}{
+		{name: "Claimed", affected: 1, claimed: true},
+		{name: "AlreadyTaken", affected: 0, claimed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(deleteExpiredIdempotencyKeys)).
+				WithArgs(k.UserID).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta(claimIdempotencyKey)).
+				WithArgs(k.UserID, k.Key, k.RequestHash, k.ExpiresAt).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			mock.ExpectCommit()
+
+			claimed, err := repo.ClaimIdempotencyKey(ctx, k)
+			require.NoError(t, err)
+			require.Equal(t, tt.claimed, claimed)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRepository_GetIdempotencyKey(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	cols := []string{"user_id", "key", "request_hash", "status_code", "content_type", "response", "completed", "expires_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(getIdempotencyKey)).
+		WithArgs(uid, "missing").
+		WillReturnRows(sqlmock.NewRows(cols))
+
+	res, err := repo.GetIdempotencyKey(ctx, uid, "missing")
+	require.ErrorIs(t, err, repo2.ErrNotFound)
+	require.Nil(t, res)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getIdempotencyKey)).
+		WithArgs(uid, "key").
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(uid.String(), "key", "hash", 201, "application/json", []byte(`{}`), true, time.Now()))
+
+	res, err = repo.GetIdempotencyKey(ctx, uid, "key")
+	require.NoError(t, err)
+	require.Equal(t, "hash", res.RequestHash)
+	require.Equal(t, 201, res.StatusCode)
+	require.Equal(t, "application/json", res.ContentType)
+	require.Equal(t, []byte(`{}`), res.Response)
+	require.True(t, res.Completed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AssignPVZ(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(assignPVZ)).
+					WithArgs(uid, pvzID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}).
+							AddRow(uid.String(), pvzID.String(), now),
+					)
+				expectAudit(mock, md.AuditPVZAssigned)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Already assigned",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(assignPVZ)).
+					WithArgs(uid, pvzID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}))
+				mock.ExpectQuery(regexp.QuoteMeta(getAssignment)).
+					WithArgs(uid, pvzID).
+					WillReturnRows(
+						sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}).
+							AddRow(uid.String(), pvzID.String(), now),
+					)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Employee or PVZ not found",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(assignPVZ)).
+					WithArgs(uid, pvzID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}))
+				mock.ExpectQuery(regexp.QuoteMeta(getAssignment)).
+					WithArgs(uid, pvzID).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.AssignPVZ(ctx, uid, pvzID)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, &dto.PVZAssignment{UserId: uid, PvzId: pvzID, CreatedAt: dto.NewOptDateTime(now)}, res)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_Assignments(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(listAssignments)).
+		WithArgs(uid).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}).
+				AddRow(uid.String(), pvzID.String(), time.Now()),
+		)
+
+	list, err := repo.ListAssignments(ctx, uid)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, pvzID, list[0].PvzId)
+
+	mock.ExpectQuery(regexp.QuoteMeta(isAssigned)).
+		WithArgs(uid, pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	ok, err := repo.IsAssigned(ctx, uid, pvzID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(unassignPVZ)).
+		WithArgs(uid, pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}))
+	mock.ExpectRollback()
+	require.ErrorIs(t, repo.UnassignPVZ(ctx, uid, pvzID), repo2.ErrNotFound)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(unassignPVZ)).
+		WithArgs(uid, pvzID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"user_id", "pickup_point_id", "created_at"}).
+				AddRow(uid.String(), pvzID.String(), time.Now()),
+		)
+	expectAudit(mock, md.AuditPVZUnassigned)
+	mock.ExpectCommit()
+	require.NoError(t, repo.UnassignPVZ(ctx, uid, pvzID))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AuditContext(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "host/req-000001")
+	ctx = context.WithValue(ctx, "uid", uid)
+	ctx = context.WithValue(ctx, "role", md.EmployeeRole)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+		WithArgs(pvzID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "created_at", "pickup_point_id", "status"}).
+				AddRow(receptionID.String(), time.Now(), pvzID.String(), "in_progress"),
+		)
+	mock.ExpectQuery(regexp.QuoteMeta(deleteLastProduct)).
+		WithArgs(receptionID, uid.String()).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "reception_id", "type", "created_at", "created_by", "deleted_at", "deleted_by"}).
+				AddRow(productID.String(), receptionID.String(), "обувь", time.Now(), uid.String(), time.Now(), uid.String()),
+		)
+	mock.ExpectExec(regexp.QuoteMeta(insertAudit)).
+		WithArgs(
+			uid.String(),
+			md.EmployeeRole,
+			md.AuditProductDeleted,
+			pvzID.String(),
+			receptionID.String(),
+			productID.String(),
+			"host/req-000001",
+			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = repo.DeleteLastProduct(ctx, pvzID, uid)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListAudit(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	pvzID := uuid.New()
+	actorID := uuid.New()
+	start := time.Now().Add(-time.Hour)
+	now := time.Now()
+	columns := []string{
+		"id", "actor_id", "actor_role", "action", "pvz_id", "reception_id", "product_id",
+		"request_id", "before", "after", "created_at",
+	}
+
+	tests := []struct {
+		name    string
+		filter  *md.AuditFilter
+		setup   func()
+		wantErr bool
+		wantLen int
+	}{
+		{
+			name:   "All filters",
+			filter: &md.AuditFilter{PVZID: pvzID, ActorID: actorID, Action: md.AuditProductDeleted, StartDate: start, EndDate: now, Page: 2, Limit: 10},
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(listAudit)).
+					WithArgs(pvzID.String(), actorID.String(), md.AuditProductDeleted, start, now, int64(10), int64(10)).
+					WillReturnRows(
+						sqlmock.NewRows(columns).AddRow(
+							int64(7), actorID.String(), md.EmployeeRole, md.AuditProductDeleted,
+							pvzID.String(), uuid.NewString(), uuid.NewString(),
+							"req-1", []byte(`{"type":"обувь"}`), nil, now,
+						),
+					)
+			},
+			wantLen: 1,
+		},
+		{
+			name:   "No filters",
+			filter: &md.AuditFilter{Page: 1, Limit: 50},
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(listAudit)).
+					WithArgs(nil, nil, nil, nil, nil, int64(50), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			wantLen: 0,
+		},
+		{
+			name:   "DB error",
+			filter: &md.AuditFilter{Page: 1, Limit: 50},
+			setup: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(listAudit)).
+					WithArgs(nil, nil, nil, nil, nil, int64(50), int64(0)).
+					WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.ListAudit(ctx, tt.filter)
+				if tt.wantErr {
+					require.Error(t, err)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Len(t, res, tt.wantLen)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(listAudit)).
+		WillReturnRows(
+			sqlmock.NewRows(columns).AddRow(
+				int64(1), nil, nil, md.AuditUserRegistered, nil, nil, nil, nil, nil, []byte(`{"email":"a@b.c"}`), now,
+			),
+		)
+	res, err := repo.ListAudit(ctx, &md.AuditFilter{Page: 1, Limit: 1})
+	require.NoError(t, err)
+	require.False(t, res[0].ActorId.Set)
+	require.Nil(t, res[0].Before)
+	require.JSONEq(t, `{"email":"a@b.c"}`, string(res[0].After))
+}
+
+func TestRepository_ReplaceManifest(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	uid := uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	receptionColumns := []string{"id", "status", "created_at", "pickup_point_id", "created_by"}
+	items := []dto.ManifestItem{
+		{Type: dto.NewOptString("обувь"), Quantity: 2},
+		{Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+	}
+	expectReception := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(findReceptionForUpdate)).
+			WithArgs(receptionID).
+			WillReturnRows(
+				sqlmock.NewRows(receptionColumns).
+					AddRow(receptionID.String(), "in_progress", time.Now(), pvzID.String(), nil),
+			)
+	}
+	expectManifest := func() {
+		mock.ExpectQuery(regexp.QuoteMeta(upsertManifest)).
+			WithArgs(receptionID, true, uid.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+		mock.ExpectExec(regexp.QuoteMeta(deleteManifestItems)).
+			WithArgs(receptionID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "ReceptionIsNotInProgress",
+			setup: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(findReceptionForUpdate)).
+					WithArgs(receptionID).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrNoActiveReception,
+		},
+		{
+			name: "UnknownType",
+			setup: func() {
+				mock.ExpectBegin()
+				expectReception()
+				expectManifest()
+				mock.ExpectExec(regexp.QuoteMeta(insertManifestItem)).
+					WithArgs(receptionID, "обувь", nil, 2).
+					WillReturnError(&pgconn.PgError{Code: "23503"})
+				mock.ExpectRollback()
+			},
+			wantErr: repo2.ErrTypeIsNotValid,
+		},
+		{
+			name: "Success",
+			setup: func() {
+				mock.ExpectBegin()
+				expectReception()
+				expectManifest()
+				mock.ExpectExec(regexp.QuoteMeta(insertManifestItem)).
+					WithArgs(receptionID, "обувь", nil, 2).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(regexp.QuoteMeta(insertManifestItem)).
+					WithArgs(receptionID, nil, "4006381333931", 1).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				expectAudit(mock, md.AuditManifestUploaded)
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				tt.setup()
+				res, err := repo.ReplaceManifest(ctx, receptionID, true, items, uid)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Nil(t, res)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, receptionID, res.ReceptionId)
+					require.True(t, res.Strict)
+					require.Equal(t, items, res.Items)
+					require.Equal(t, uid, res.CreatedBy.Value)
+				}
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		)
+	}
+}
+
+func TestRepository_GetManifest(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+	receptionID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(getManifest)).
+		WithArgs(receptionID).
+		WillReturnError(sql.ErrNoRows)
+	res, err := repo.GetManifest(ctx, receptionID)
+	require.ErrorIs(t, err, repo2.ErrNotFound)
+	require.Nil(t, res)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getManifest)).
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"strict", "created_at", "created_by"}).AddRow(true, time.Now(), nil))
+	mock.ExpectQuery(regexp.QuoteMeta(getManifestItems)).
+		WithArgs(receptionID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"type", "barcode", "quantity"}).
+				AddRow("обувь", nil, 2).
+				AddRow(nil, "4006381333931", 1),
+		)
+	res, err = repo.GetManifest(ctx, receptionID)
+	require.NoError(t, err)
+	require.True(t, res.Strict)
+	require.False(t, res.CreatedBy.Set)
+	require.Equal(
+		t, []dto.ManifestItem{
+			{Type: dto.NewOptString("обувь"), Quantity: 2},
+			{Barcode: dto.NewOptString("4006381333931"), Quantity: 1},
+		}, res.Items,
+	)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetReceptionProductCounts(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	receptionID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(getReceptionProductCounts)).
+		WithArgs(receptionID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"type", "barcode", "quantity"}).
+				AddRow("обувь", nil, 3).
+				AddRow("одежда", "4006381333931", 1),
+		)
+
+	res, err := repo.GetReceptionProductCounts(context.Background(), receptionID)
+	require.NoError(t, err)
+	require.Equal(
+		t, []*md.ProductCount{
+			{Type: "обувь", Quantity: 3},
+			{Type: "одежда", Barcode: sql.NullString{String: "4006381333931", Valid: true}, Quantity: 1},
+		}, res,
+	)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateReceptionConcurrently(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	db := sqlx.NewDb(mockDB, "sqlmock")
+	repo := Repository{conn: db}
+	ctx := context.Background()
+
+	const workers = 20
+	uid := uuid.New()
+	req := &dto.ReceptionsPostReq{PvzId: uuid.New()}
+
+	// Every worker passes the pre-check, only the first insert wins the unique index.
+	mock.MatchExpectationsInOrder(false)
+	for i := 0; i < workers; i++ {
+		mock.ExpectBegin()
+		expectPVZStatus(mock, req.PvzId, md.PVZStatusActive)
+		mock.ExpectQuery(regexp.QuoteMeta(findLastReceptionForUpdate)).
+			WithArgs(req.PvzId).
+			WillReturnError(sql.ErrNoRows)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(createReception)).
+		WithArgs(req.PvzId, uid.String()).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "status", "created_at", "pickup_point_id", "created_by"}).
+				AddRow(uuid.NewString(), "in_progress", time.Now(), req.PvzId.String(), uid.String()),
+		)
+	expectAudit(mock, md.AuditReceptionOpened)
+	mock.ExpectCommit()
+	for i := 1; i < workers; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta(createReception)).
+			WithArgs(req.PvzId, uid.String()).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "uniq_receptions_pvz_in_progress"})
+		mock.ExpectRollback()
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		created int
+		errs    []error
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := repo.CreateReception(ctx, req, uid)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			require.Equal(t, req.PvzId, res.PvzId)
+			created++
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, created)
+	require.Len(t, errs, workers-1)
+	for _, err := range errs {
+		require.ErrorIs(t, err, repo2.ErrReceptionStillOpen)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectAudit(mock sqlmock.Sqlmock, action string) {
+	mock.ExpectExec(regexp.QuoteMeta(insertAudit)).
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), action,
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func expectPVZStatus(mock sqlmock.Sqlmock, pvzID uuid.UUID, status string) {
+	mock.ExpectQuery(regexp.QuoteMeta(findPVZStatusForShare)).
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+}
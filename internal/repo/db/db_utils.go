@@ -4,12 +4,16 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/JMURv/avito-spring/internal/config"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
 	migrate "github.com/golang-migrate/migrate/v4"
 	pgx "github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 func applyMigrations(db *sql.DB, conf config.Config) error {
@@ -44,3 +48,164 @@ func applyMigrations(db *sql.DB, conf config.Config) error {
 	zap.L().Info("Applied migrations")
 	return nil
 }
+
+// cursorArgs turns an optional keyset cursor into nullable query arguments.
+func cursorArgs(after *md.PVZCursor) (any, any) {
+	if after == nil {
+		return nil, nil
+	}
+	return after.CreatedAt, after.ID
+}
+
+// scanDictionaryEntry reads a (name, is_active, created_at) row of the cities or product_types tables.
+func scanDictionaryEntry(row interface{ Scan(...any) error }) (*dto.DictionaryEntry, error) {
+	var (
+		name      string
+		active    bool
+		createdAt time.Time
+	)
+	if err := row.Scan(&name, &active, &createdAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.DictionaryEntry{
+		Name:      name,
+		IsActive:  dto.NewOptBool(active),
+		CreatedAt: dto.NewOptDateTime(createdAt),
+	}, nil
+}
+
+// nullUUID stores requests made without an authenticated user as NULL.
+func nullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+func optUUID(id uuid.NullUUID) dto.OptUUID {
+	if !id.Valid {
+		return dto.OptUUID{}
+	}
+	return dto.NewOptUUID(id.UUID)
+}
+
+func scanAssignment(row interface{ Scan(...any) error }) (*dto.PVZAssignment, error) {
+	var (
+		uid       uuid.UUID
+		pvzID     uuid.UUID
+		createdAt time.Time
+	)
+	if err := row.Scan(&uid, &pvzID, &createdAt); err != nil {
+		return nil, err
+	}
+
+	return &dto.PVZAssignment{
+		UserId:    uid,
+		PvzId:     pvzID,
+		CreatedAt: dto.NewOptDateTime(createdAt),
+	}, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func optString(s sql.NullString) dto.OptString {
+	if !s.Valid {
+		return dto.OptString{}
+	}
+	return dto.NewOptString(s.String)
+}
+
+func optFloat64(f sql.NullFloat64) dto.OptFloat64 {
+	if !f.Valid {
+		return dto.OptFloat64{}
+	}
+	return dto.NewOptFloat64(f.Float64)
+}
+
+func optInt(i sql.NullInt64) dto.OptInt {
+	if !i.Valid {
+		return dto.OptInt{}
+	}
+	return dto.NewOptInt(int(i.Int64))
+}
+
+func optCoordinates(lat, lon sql.NullFloat64) dto.OptCoordinates {
+	if !lat.Valid || !lon.Valid {
+		return dto.OptCoordinates{}
+	}
+	return dto.NewOptCoordinates(dto.Coordinates{Latitude: lat.Float64, Longitude: lon.Float64})
+}
+
+func optDateTime(t sql.NullTime) dto.OptDateTime {
+	if !t.Valid {
+		return dto.OptDateTime{}
+	}
+	return dto.NewOptDateTime(t.Time)
+}
+
+func receptionToDTO(r *md.Reception) *dto.Reception {
+	return &dto.Reception{
+		ID:        dto.NewOptUUID(r.ID),
+		DateTime:  r.DateTime,
+		PvzId:     r.PVZID,
+		Status:    dto.ReceptionStatus(r.Status),
+		CreatedBy: optUUID(r.CreatedBy),
+	}
+}
+
+func pvzToDTO(p *md.PVZ) *dto.PVZ {
+	return &dto.PVZ{
+		ID:               dto.NewOptUUID(p.ID),
+		RegistrationDate: dto.NewOptDateTime(p.RegistrationDate),
+		City:             p.City,
+		Name:             optString(p.Name),
+		Address:          optString(p.Address),
+		WorkingHours:     workingHoursToDTO(p.WorkingHours),
+		Timezone:         optString(p.Timezone),
+		Coordinates:      optCoordinates(p.Latitude, p.Longitude),
+		Capacity:         optInt(p.Capacity),
+		Status:           dto.NewOptPVZStatus(dto.PVZStatus(p.Status)),
+		ClosedAt:         optDateTime(p.ClosedAt),
+	}
+}
+
+func workingHoursToDTO(w md.WorkingHours) dto.WorkingHours {
+	if w == nil {
+		return nil
+	}
+
+	res := make(dto.WorkingHours, len(w))
+	for i := range w {
+		res[i] = dto.WorkingHoursItem{Day: dto.WorkingHoursItemDay(w[i].Day), Open: w[i].Open, Close: w[i].Close}
+	}
+	return res
+}
+
+func workingHoursFromDTO(w dto.WorkingHours) md.WorkingHours {
+	if w == nil {
+		return nil
+	}
+
+	res := make(md.WorkingHours, len(w))
+	for i := range w {
+		res[i] = md.WorkingDay{Day: string(w[i].Day), Open: w[i].Open, Close: w[i].Close}
+	}
+	return res
+}
+
+func productToDTO(p *md.Product) *dto.Product {
+	return &dto.Product{
+		ID:          dto.NewOptUUID(p.ID),
+		DateTime:    dto.NewOptDateTime(p.DateTime),
+		ScannedAt:   optDateTime(p.ScannedAt),
+		Type:        p.Type,
+		ReceptionId: p.ReceptionId,
+		CreatedBy:   optUUID(p.CreatedBy),
+		DeletedAt:   optDateTime(p.DeletedAt),
+		DeletedBy:   optUUID(p.DeletedBy),
+		Barcode:     optString(p.Barcode),
+		Sku:         optString(p.SKU),
+		Quantity:    dto.NewOptInt(p.Quantity),
+		Weight:      optFloat64(p.Weight),
+	}
+}
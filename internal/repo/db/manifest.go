@@ -0,0 +1,187 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+	"time"
+)
+
+// ReplaceManifest stores items as the manifest of the reception, replacing the previous one.
+// The reception is locked for the duration, so it cannot be closed while the manifest is being replaced.
+func (r *Repository) ReplaceManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem, uid uuid.UUID) (*dto.Manifest, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var reception md.Reception
+	err = tx.GetContext(ctx, &reception, findReceptionForUpdate, receptionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNoActiveReception
+		}
+		return nil, err
+	}
+
+	var createdAt time.Time
+	err = tx.GetContext(ctx, &createdAt, upsertManifest, receptionID, strict, nullUUID(uid))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteManifestItems, receptionID); err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		_, err = tx.ExecContext(
+			ctx, insertManifestItem,
+			receptionID,
+			nullString(items[i].Type.Or("")),
+			nullString(items[i].Barcode.Or("")),
+			items[i].Quantity,
+		)
+		if err != nil {
+			if pgErr, ok := err.(*pgconn.PgError); ok {
+				if pgErr.Code == "23503" {
+					return nil, repo.ErrTypeIsNotValid
+				}
+			}
+			return nil, err
+		}
+	}
+
+	res := &dto.Manifest{
+		ReceptionId: receptionID,
+		Strict:      strict,
+		Items:       items,
+		CreatedAt:   dto.NewOptDateTime(createdAt),
+		CreatedBy:   optUUID(nullUUID(uid)),
+	}
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action:      md.AuditManifestUploaded,
+			PVZID:       reception.PVZID,
+			ReceptionID: receptionID,
+			After:       res,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error) {
+	return getManifestWith(ctx, r.conn, receptionID)
+}
+
+// GetReceptionProductCounts returns the live units of the reception grouped by type and barcode.
+func (r *Repository) GetReceptionProductCounts(ctx context.Context, receptionID uuid.UUID) ([]*md.ProductCount, error) {
+	return getProductCountsWith(ctx, r.conn, receptionID)
+}
+
+// checkManifest passes the manifest of the reception and its accepted products to check. Receptions without
+// a manifest are not checked and get no report.
+func checkManifest(ctx context.Context, q sqlx.QueryerContext, receptionID uuid.UUID, check repo.ManifestCheck) (*dto.DiscrepancyReport, error) {
+	if check == nil {
+		return nil, nil
+	}
+
+	manifest, err := getManifestWith(ctx, q, receptionID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	counts, err := getProductCountsWith(ctx, q, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	return check(manifest, counts)
+}
+
+func getManifestWith(ctx context.Context, q sqlx.QueryerContext, receptionID uuid.UUID) (*dto.Manifest, error) {
+	var (
+		strict    bool
+		createdAt time.Time
+		createdBy uuid.NullUUID
+	)
+	err := q.QueryRowxContext(ctx, getManifest, receptionID).Scan(&strict, &createdAt, &createdBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := q.QueryContext(ctx, getManifestItems, receptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sql.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	res := &dto.Manifest{
+		ReceptionId: receptionID,
+		Strict:      strict,
+		Items:       make([]dto.ManifestItem, 0),
+		CreatedAt:   dto.NewOptDateTime(createdAt),
+		CreatedBy:   optUUID(createdBy),
+	}
+	for rows.Next() {
+		var (
+			typ      sql.NullString
+			barcode  sql.NullString
+			quantity int
+		)
+		if err = rows.Scan(&typ, &barcode, &quantity); err != nil {
+			return nil, err
+		}
+
+		res.Items = append(
+			res.Items, dto.ManifestItem{
+				Type:     optString(typ),
+				Barcode:  optString(barcode),
+				Quantity: quantity,
+			},
+		)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func getProductCountsWith(ctx context.Context, q sqlx.QueryerContext, receptionID uuid.UUID) ([]*md.ProductCount, error) {
+	res := make([]*md.ProductCount, 0)
+	if err := sqlx.SelectContext(ctx, q, &res, getReceptionProductCounts, receptionID); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_by UUID;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_pickup_points_created_at_id ON pickup_points(created_at, id);
@@ -0,0 +1,13 @@
+CREATE TYPE allowed_city AS ENUM ('Москва', 'Санкт-Петербург', 'Казань');
+CREATE TYPE product_type AS ENUM ('электроника', 'одежда', 'обувь');
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS fk_products_type,
+    ALTER COLUMN type TYPE product_type USING type::product_type;
+
+ALTER TABLE pickup_points
+    DROP CONSTRAINT IF EXISTS fk_pickup_points_city,
+    ALTER COLUMN city TYPE allowed_city USING city::allowed_city;
+
+DROP TABLE IF EXISTS product_types;
+DROP TABLE IF EXISTS cities;
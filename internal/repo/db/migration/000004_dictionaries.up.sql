@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS cities (
+    name VARCHAR(255) PRIMARY KEY,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS product_types (
+    name VARCHAR(255) PRIMARY KEY,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL
+);
+
+INSERT INTO cities (name)
+SELECT unnest(enum_range(NULL::allowed_city))::text
+ON CONFLICT DO NOTHING;
+
+INSERT INTO product_types (name)
+SELECT unnest(enum_range(NULL::product_type))::text
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE pickup_points
+    ALTER COLUMN city TYPE VARCHAR(255) USING city::text,
+    ADD CONSTRAINT fk_pickup_points_city FOREIGN KEY (city) REFERENCES cities(name);
+
+ALTER TABLE products
+    ALTER COLUMN type TYPE VARCHAR(255) USING type::text,
+    ADD CONSTRAINT fk_products_type FOREIGN KEY (type) REFERENCES product_types(name);
+
+DROP TYPE IF EXISTS allowed_city;
+DROP TYPE IF EXISTS product_type;
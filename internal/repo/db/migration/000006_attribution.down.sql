@@ -0,0 +1,2 @@
+ALTER TABLE products DROP COLUMN IF EXISTS created_by;
+ALTER TABLE receptions DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,2 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS created_by UUID;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS created_by UUID;
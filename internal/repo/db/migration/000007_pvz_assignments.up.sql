@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS pvz_assignments (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pickup_point_id UUID NOT NULL REFERENCES pickup_points(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    PRIMARY KEY (user_id, pickup_point_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pvz_assignments_pickup_point ON pvz_assignments(pickup_point_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_products_reception_deleted;
+
+ALTER TABLE products DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE products DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_barcode;
+DROP INDEX IF EXISTS uq_products_reception_barcode;
+
+ALTER TABLE products DROP COLUMN IF EXISTS weight;
+ALTER TABLE products DROP COLUMN IF EXISTS quantity;
+ALTER TABLE products DROP COLUMN IF EXISTS sku;
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
@@ -0,0 +1,7 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode VARCHAR(48);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS sku VARCHAR(64);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS quantity INT NOT NULL DEFAULT 1 CHECK (quantity > 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS weight NUMERIC(10, 3) CHECK (weight > 0);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_products_reception_barcode ON products(reception_id, barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_products_barcode ON products(barcode) WHERE barcode IS NOT NULL;
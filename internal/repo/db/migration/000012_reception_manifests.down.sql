@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_manifest_items_reception;
+
+DROP TABLE IF EXISTS manifest_items;
+DROP TABLE IF EXISTS manifests;
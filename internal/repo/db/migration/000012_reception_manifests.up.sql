@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS manifests (
+    reception_id UUID PRIMARY KEY REFERENCES receptions(id) ON DELETE CASCADE,
+    strict BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_by UUID
+);
+
+CREATE TABLE IF NOT EXISTS manifest_items (
+    id BIGSERIAL PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES manifests(reception_id) ON DELETE CASCADE,
+    type VARCHAR(255) REFERENCES product_types(name),
+    barcode VARCHAR(48),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    CHECK (type IS NOT NULL OR barcode IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_manifest_items_reception ON manifest_items(reception_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_receptions_in_progress;
+
+ALTER TABLE receptions DROP COLUMN IF EXISTS close_reason;
+ALTER TABLE receptions DROP COLUMN IF EXISTS auto_closed;
@@ -0,0 +1,4 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS auto_closed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS close_reason VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_receptions_in_progress ON receptions(created_at) WHERE status = 'in_progress';
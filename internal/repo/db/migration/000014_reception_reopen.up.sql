@@ -0,0 +1,3 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_at TIMESTAMPTZ;
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_by UUID;
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopen_reason VARCHAR(255);
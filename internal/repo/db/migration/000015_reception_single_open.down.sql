@@ -0,0 +1 @@
+DROP INDEX IF EXISTS uniq_receptions_pvz_in_progress;
@@ -0,0 +1,10 @@
+-- Only the newest open reception of a PVZ is kept open so the unique index can be built.
+UPDATE receptions r
+SET status = 'closed', closed_at = NOW(), auto_closed = TRUE, close_reason = 'superseded by a newer open reception'
+WHERE r.status = 'in_progress' AND EXISTS (
+    SELECT 1
+    FROM receptions n
+    WHERE n.pickup_point_id = r.pickup_point_id AND n.status = 'in_progress' AND n.created_at > r.created_at
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_receptions_pvz_in_progress ON receptions(pickup_point_id) WHERE status = 'in_progress';
@@ -0,0 +1,8 @@
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_status;
+
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS closed_by;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS status;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS working_hours;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS address;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS name;
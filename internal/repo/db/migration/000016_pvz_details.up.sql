@@ -0,0 +1,9 @@
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS name VARCHAR(255);
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS address VARCHAR(512);
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS working_hours JSONB;
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active';
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS closed_by UUID;
+
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_status;
+ALTER TABLE pickup_points ADD CONSTRAINT chk_pickup_points_status CHECK (status IN ('active', 'closed'));
@@ -0,0 +1,7 @@
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_capacity;
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_coordinates;
+
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS timezone;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS capacity;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS longitude;
+ALTER TABLE pickup_points DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,16 @@
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS capacity INTEGER;
+ALTER TABLE pickup_points ADD COLUMN IF NOT EXISTS timezone VARCHAR(64);
+
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_coordinates;
+ALTER TABLE pickup_points ADD CONSTRAINT chk_pickup_points_coordinates CHECK (
+    (latitude IS NULL AND longitude IS NULL)
+    OR (
+        latitude IS NOT NULL AND longitude IS NOT NULL
+        AND latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180
+    )
+);
+
+ALTER TABLE pickup_points DROP CONSTRAINT IF EXISTS chk_pickup_points_capacity;
+ALTER TABLE pickup_points ADD CONSTRAINT chk_pickup_points_capacity CHECK (capacity IS NULL OR capacity > 0);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_pickup_points_latitude;
@@ -0,0 +1,2 @@
+-- Nearby search narrows the candidates by a latitude band before computing distances.
+CREATE INDEX IF NOT EXISTS idx_pickup_points_latitude ON pickup_points(latitude) WHERE status = 'active' AND latitude IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Replayed HTTP responses keep the Content-Type of the original one.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS content_type VARCHAR(255);
@@ -0,0 +1,2 @@
+ALTER TABLE products ALTER COLUMN created_at SET DEFAULT NOW();
+ALTER TABLE products DROP COLUMN IF EXISTS scanned_at;
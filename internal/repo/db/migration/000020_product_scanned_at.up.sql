@@ -0,0 +1,4 @@
+-- created_at is the server insertion time and orders the products of a reception,
+-- the time reported by the scanning device is kept apart.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS scanned_at TIMESTAMPTZ;
+ALTER TABLE products ALTER COLUMN created_at SET DEFAULT clock_timestamp();
@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+	"github.com/JMURv/avito-spring/internal/repo"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+func (r *Repository) GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
+	var res md.PVZ
+	if err := r.conn.GetContext(ctx, &res, getPVZByID, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+	return pvzToDTO(&res), nil
+}
+
+// FindNearbyPVZs returns a page of at most limit active PVZs within radius meters of the point, nearest first,
+// starting after the given position. Distances are computed with the haversine formula, a latitude band around
+// the point narrows the candidates first.
+func (r *Repository) FindNearbyPVZs(ctx context.Context, lat, lon, radius float64, after *md.NearbyCursor, limit int64) ([]*dto.NearbyPVZ, error) {
+	var afterDistance, afterID any
+	if after != nil {
+		afterDistance, afterID = after.Distance, after.ID
+	}
+
+	rows, err := r.conn.QueryxContext(ctx, findNearbyPVZs, lat, lon, radius, limit, afterDistance, afterID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(rows *sqlx.Rows) {
+		if err := rows.Close(); err != nil {
+			zap.L().Error("Failed to close rows", zap.Error(err))
+		}
+	}(rows)
+
+	res := make([]*dto.NearbyPVZ, 0)
+	for rows.Next() {
+		var p struct {
+			md.PVZ
+			Distance float64 `db:"distance"`
+		}
+		if err = rows.StructScan(&p); err != nil {
+			return nil, err
+		}
+		res = append(res, &dto.NearbyPVZ{Pvz: *pvzToDTO(&p.PVZ), Distance: p.Distance})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdatePVZ changes the fields set in req, the rest are left as they are. A closed PVZ cannot be changed,
+// and the PVZ must keep a timezone as long as it has working hours.
+func (r *Repository) UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var prev md.PVZ
+	if err = tx.GetContext(ctx, &prev, findPVZForUpdate, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if prev.Status == md.PVZStatusClosed {
+		return nil, repo.ErrPVZClosed
+	}
+
+	// The timezone cannot be unset by an update, so it is enough to check the working hours the PVZ ends up with.
+	hasHours := len(prev.WorkingHours) > 0
+	if req.WorkingHours != nil {
+		hasHours = len(req.WorkingHours) > 0
+	}
+	if hasHours && !prev.Timezone.Valid && !req.Timezone.Set {
+		return nil, repo.ErrTimezoneRequired
+	}
+
+	if req.City.Set {
+		var active bool
+		if err = tx.GetContext(ctx, &active, isCityActive, req.City.Value); err != nil {
+			return nil, err
+		}
+		if !active {
+			return nil, repo.ErrCityIsNotValid
+		}
+	}
+
+	var res md.PVZ
+	err = tx.GetContext(
+		ctx, &res, updatePVZ,
+		id,
+		nullString(req.City.Or("")),
+		nullString(req.Name.Or("")),
+		nullString(req.Address.Or("")),
+		workingHoursFromDTO(req.WorkingHours),
+		nullString(req.Timezone.Or("")),
+		sql.NullFloat64{Float64: req.Coordinates.Value.Latitude, Valid: req.Coordinates.Set},
+		sql.NullFloat64{Float64: req.Coordinates.Value.Longitude, Valid: req.Coordinates.Set},
+		sql.NullInt64{Int64: int64(req.Capacity.Value), Valid: req.Capacity.Set},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	after := pvzToDTO(&res)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action: md.AuditPVZUpdated,
+			PVZID:  id,
+			Before: pvzToDTO(&prev),
+			After:  after,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// DecommissionPVZ closes the PVZ on behalf of uid. It is refused while the PVZ has a reception in progress,
+// and CreateReception waits for it, so no reception can be opened in a closed PVZ.
+func (r *Repository) DecommissionPVZ(ctx context.Context, id, uid uuid.UUID) (*dto.PVZ, error) {
+	tx, err := r.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(tx *sqlx.Tx) {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			zap.L().Error("Failed to rollback transaction", zap.Error(err))
+		}
+	}(tx)
+
+	var prev md.PVZ
+	if err = tx.GetContext(ctx, &prev, findPVZForUpdate, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if prev.Status == md.PVZStatusClosed {
+		return nil, repo.ErrPVZClosed
+	}
+
+	var open bool
+	if err = tx.GetContext(ctx, &open, hasOpenReception, id); err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, repo.ErrReceptionStillOpen
+	}
+
+	var res md.PVZ
+	if err = tx.GetContext(ctx, &res, decommissionPVZ, id, nullUUID(uid)); err != nil {
+		return nil, err
+	}
+
+	after := pvzToDTO(&res)
+	err = writeAudit(
+		ctx, tx, &md.AuditEntry{
+			Action: md.AuditPVZDecommissioned,
+			PVZID:  id,
+			Before: pvzToDTO(&prev),
+			After:  after,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
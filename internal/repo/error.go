@@ -9,3 +9,10 @@ var ErrReceptionAlreadyClosed = errors.New("reception already closed")
 var ErrNoItems = errors.New("no items")
 var ErrReceptionStillOpen = errors.New("reception still open")
 var ErrNoActiveReception = errors.New("no active reception")
+var ErrAlreadyExists = errors.New("already exists")
+var ErrInUse = errors.New("in use")
+var ErrNoDeletedItems = errors.New("no deleted items")
+var ErrNewerReceptionExists = errors.New("newer reception exists")
+var ErrReopenWindowExpired = errors.New("reopen window expired")
+var ErrPVZClosed = errors.New("pvz is closed")
+var ErrTimezoneRequired = errors.New("timezone required")
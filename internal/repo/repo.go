@@ -0,0 +1,10 @@
+package repo
+
+import (
+	dto "github.com/JMURv/avito-spring/internal/dto/gen"
+	md "github.com/JMURv/avito-spring/internal/models"
+)
+
+// ManifestCheck compares the products accepted by a reception with its manifest. It is run by the repository
+// while the reception is locked, an error refuses to close the reception.
+type ManifestCheck func(m *dto.Manifest, counts []*md.ProductCount) (*dto.DiscrepancyReport, error)
@@ -14,6 +14,7 @@ import (
 	mid "github.com/JMURv/avito-spring/internal/hdl/http/middleware"
 	"github.com/JMURv/avito-spring/internal/repo/db"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -21,6 +22,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -36,8 +39,8 @@ func setupTestServer() (*httptest.Server, func()) {
 	zap.ReplaceGlobals(zap.Must(zap.NewDevelopment()))
 
 	conf := config.MustLoad(configPath)
-	au := auth.New(conf)
 	repo := db.New(conf)
+	au := auth.New(conf, repo)
 	svc := ctrl.New(repo, au)
 	h := hdl.New(svc, au)
 	h.Router.Use(
@@ -165,6 +168,20 @@ func TestFullReceptionFlow(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusCreated, resp.StatusCode)
 
+	var empRes dto.User
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&empRes))
+	resp.Body.Close()
+
+	// Закрепление сотрудника за ПВЗ
+	url := fmt.Sprintf("/users/%s/pvz/%s", empRes.ID.Value.String(), pvzRes.ID.Value.String())
+	req, err = http.NewRequest(http.MethodPut, srv.URL+url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", authHeader)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
 	// Логин сотрудника
 	loginEmp := dto.LoginPostReq{
 		Email:    "emp@avito.ru",
@@ -214,7 +231,7 @@ func TestFullReceptionFlow(t *testing.T) {
 	}
 
 	// Закрытие приёмки
-	url := fmt.Sprintf("/pvz/%s/close_last_reception", pvzRes.ID.Value.String())
+	url = fmt.Sprintf("/pvz/%s/close_last_reception", pvzRes.ID.Value.String())
 	req, err = http.NewRequest(http.MethodPost, srv.URL+url, nil)
 	require.NoError(t, err)
 	req.Header.Set("Authorization", authHeader)
@@ -223,3 +240,163 @@ func TestFullReceptionFlow(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 	resp.Body.Close()
 }
+
+// TestConcurrentAddAndClose closes the reception while products are being added to it. Every product
+// is either accepted before the reception is closed or rejected with ErrNoActiveReception.
+func TestConcurrentAddAndClose(t *testing.T) {
+	srv, cleanup := setupTestServer()
+	t.Cleanup(cleanup)
+
+	client := srv.Client()
+	pvzID, authHeader := setupEmployee(t, srv, "race")
+
+	buf, err := json.Marshal(dto.ReceptionsPostReq{PvzId: pvzID})
+	require.NoError(t, err)
+	resp := doRequest(t, client, http.MethodPost, srv.URL+"/receptions", authHeader, buf)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var reception dto.Reception
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&reception))
+	resp.Body.Close()
+
+	buf, err = json.Marshal(dto.ProductsPostReq{Type: "электроника", PvzId: pvzID})
+	require.NoError(t, err)
+
+	const workers = 8
+	const perWorker = 25
+	var (
+		wg       sync.WaitGroup
+		accepted atomic.Int64
+		rejected atomic.Int64
+		start    = make(chan struct{})
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			for j := 0; j < perWorker; j++ {
+				req, err := http.NewRequest(http.MethodPost, srv.URL+"/products", bytes.NewReader(buf))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				req.Header.Set("Authorization", authHeader)
+				req.Header.Set("Content-Type", "application/json")
+
+				resp, err := client.Do(req)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				body, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+
+				switch {
+				case resp.StatusCode == http.StatusCreated:
+					accepted.Add(1)
+				case resp.StatusCode == http.StatusBadRequest && strings.Contains(string(body), ctrl.ErrNoActiveReception.Error()):
+					rejected.Add(1)
+				default:
+					t.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+				}
+			}
+		}()
+	}
+
+	close(start)
+	time.Sleep(50 * time.Millisecond)
+	url := fmt.Sprintf("%s/pvz/%s/close_last_reception", srv.URL, pvzID)
+	resp = doRequest(t, client, http.MethodPost, url, authHeader, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var closed dto.Reception
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&closed))
+	resp.Body.Close()
+	wg.Wait()
+
+	require.EqualValues(t, workers*perWorker, accepted.Load()+rejected.Load())
+
+	url = fmt.Sprintf("%s/receptions/%s/summary", srv.URL, reception.ID.Value)
+	resp = doRequest(t, client, http.MethodGet, url, authHeader, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var summary dto.ReceptionSummary
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&summary))
+	resp.Body.Close()
+
+	// Nothing may land in the reception once it is closed.
+	require.Equal(t, closed.Summary.Value.TotalProducts, summary.TotalProducts)
+	require.Equal(t, accepted.Load(), summary.TotalProducts)
+}
+
+// setupEmployee creates a PVZ and an employee assigned to it and returns the PVZ id with the employee's auth header.
+func setupEmployee(t *testing.T, srv *httptest.Server, name string) (uuid.UUID, string) {
+	t.Helper()
+	client := srv.Client()
+
+	modHeader := registerAndLogin(t, srv, name+"-mod@avito.ru", "moderator")
+	resp := doRequest(t, client, http.MethodPost, srv.URL+"/pvz", modHeader, []byte(`{"city": "Москва"}`))
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var pvz dto.PVZ
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&pvz))
+	resp.Body.Close()
+
+	buf, err := json.Marshal(dto.RegisterPostReq{Email: name + "-emp@avito.ru", Password: "password", Role: "employee"})
+	require.NoError(t, err)
+	resp, err = client.Post(srv.URL+"/register", "application/json", bytes.NewReader(buf))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var emp dto.User
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&emp))
+	resp.Body.Close()
+
+	url := fmt.Sprintf("%s/users/%s/pvz/%s", srv.URL, emp.ID.Value, pvz.ID.Value)
+	resp = doRequest(t, client, http.MethodPut, url, modHeader, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	return pvz.ID.Value, login(t, srv, name+"-emp@avito.ru")
+}
+
+func registerAndLogin(t *testing.T, srv *httptest.Server, email, role string) string {
+	t.Helper()
+
+	buf, err := json.Marshal(dto.RegisterPostReq{Email: email, Password: "password", Role: dto.RegisterPostReqRole(role)})
+	require.NoError(t, err)
+	resp, err := srv.Client().Post(srv.URL+"/register", "application/json", bytes.NewReader(buf))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	return login(t, srv, email)
+}
+
+func login(t *testing.T, srv *httptest.Server, email string) string {
+	t.Helper()
+
+	buf, err := json.Marshal(dto.LoginPostReq{Email: email, Password: "password"})
+	require.NoError(t, err)
+	resp, err := srv.Client().Post(srv.URL+"/login", "application/json", bytes.NewReader(buf))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return "Bearer " + strings.TrimSpace(string(body))
+}
+
+func doRequest(t *testing.T, client *http.Client, method, url, authHeader string, body []byte) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", authHeader)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	return resp
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	auth "github.com/JMURv/avito-spring/internal/auth"
 	uuid "github.com/google/uuid"
@@ -71,6 +72,61 @@ func (mr *MockCoreMockRecorder) Hash(val any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockCore)(nil).Hash), val)
 }
 
+// HashToken mocks base method.
+func (m *MockCore) HashToken(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashToken", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashToken indicates an expected call of HashToken.
+func (mr *MockCoreMockRecorder) HashToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashToken", reflect.TypeOf((*MockCore)(nil).HashToken), token)
+}
+
+// JWKS mocks base method.
+func (m *MockCore) JWKS() auth.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(auth.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockCoreMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockCore)(nil).JWKS))
+}
+
+// MarkRevoked mocks base method.
+func (m *MockCore) MarkRevoked(jti string, until time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MarkRevoked", jti, until)
+}
+
+// MarkRevoked indicates an expected call of MarkRevoked.
+func (mr *MockCoreMockRecorder) MarkRevoked(jti, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRevoked", reflect.TypeOf((*MockCore)(nil).MarkRevoked), jti, until)
+}
+
+// NewRefreshToken mocks base method.
+func (m *MockCore) NewRefreshToken() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRefreshToken")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewRefreshToken indicates an expected call of NewRefreshToken.
+func (mr *MockCoreMockRecorder) NewRefreshToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRefreshToken", reflect.TypeOf((*MockCore)(nil).NewRefreshToken))
+}
+
 // NewToken mocks base method.
 func (m *MockCore) NewToken(uid uuid.UUID, role string) (string, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 
 	dto "github.com/JMURv/avito-spring/internal/dto/gen"
 	models "github.com/JMURv/avito-spring/internal/models"
+	repo "github.com/JMURv/avito-spring/internal/repo"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -45,33 +46,123 @@ func (m *MockAppRepo) EXPECT() *MockAppRepoMockRecorder {
 }
 
 // AddItemToReception mocks base method.
-func (m *MockAppRepo) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error) {
+func (m *MockAppRepo) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq, uid uuid.UUID) (*dto.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddItemToReception", ctx, req)
+	ret := m.ctrl.Call(m, "AddItemToReception", ctx, req, uid)
 	ret0, _ := ret[0].(*dto.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddItemToReception indicates an expected call of AddItemToReception.
-func (mr *MockAppRepoMockRecorder) AddItemToReception(ctx, req any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) AddItemToReception(ctx, req, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToReception", reflect.TypeOf((*MockAppRepo)(nil).AddItemToReception), ctx, req, uid)
+}
+
+// AddItemsToReception mocks base method.
+func (m *MockAppRepo) AddItemsToReception(ctx context.Context, pvzID uuid.UUID, items []dto.ProductBatchItem, uid uuid.UUID) ([]*dto.Product, []error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItemsToReception", ctx, pvzID, items, uid)
+	ret0, _ := ret[0].([]*dto.Product)
+	ret1, _ := ret[1].([]error)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddItemsToReception indicates an expected call of AddItemsToReception.
+func (mr *MockAppRepoMockRecorder) AddItemsToReception(ctx, pvzID, items, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemsToReception", reflect.TypeOf((*MockAppRepo)(nil).AddItemsToReception), ctx, pvzID, items, uid)
+}
+
+// AssignPVZ mocks base method.
+func (m *MockAppRepo) AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignPVZ", ctx, uid, pvzID)
+	ret0, _ := ret[0].(*dto.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignPVZ indicates an expected call of AssignPVZ.
+func (mr *MockAppRepoMockRecorder) AssignPVZ(ctx, uid, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPVZ", reflect.TypeOf((*MockAppRepo)(nil).AssignPVZ), ctx, uid, pvzID)
+}
+
+// ClaimIdempotencyKey mocks base method.
+func (m *MockAppRepo) ClaimIdempotencyKey(ctx context.Context, k *models.IdempotencyKey) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimIdempotencyKey", ctx, k)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimIdempotencyKey indicates an expected call of ClaimIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) ClaimIdempotencyKey(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).ClaimIdempotencyKey), ctx, k)
+}
+
+// CloseIdleReceptions mocks base method.
+func (m *MockAppRepo) CloseIdleReceptions(ctx context.Context, idleFor time.Duration, reason string, limit int) ([]*models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseIdleReceptions", ctx, idleFor, reason, limit)
+	ret0, _ := ret[0].([]*models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseIdleReceptions indicates an expected call of CloseIdleReceptions.
+func (mr *MockAppRepoMockRecorder) CloseIdleReceptions(ctx, idleFor, reason, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToReception", reflect.TypeOf((*MockAppRepo)(nil).AddItemToReception), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseIdleReceptions", reflect.TypeOf((*MockAppRepo)(nil).CloseIdleReceptions), ctx, idleFor, reason, limit)
 }
 
 // CloseLastReception mocks base method.
-func (m *MockAppRepo) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error) {
+func (m *MockAppRepo) CloseLastReception(ctx context.Context, id, uid uuid.UUID, check repo.ManifestCheck) (*dto.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseLastReception", ctx, id)
+	ret := m.ctrl.Call(m, "CloseLastReception", ctx, id, uid, check)
 	ret0, _ := ret[0].(*dto.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseLastReception indicates an expected call of CloseLastReception.
-func (mr *MockAppRepoMockRecorder) CloseLastReception(ctx, id any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) CloseLastReception(ctx, id, uid, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockAppRepo)(nil).CloseLastReception), ctx, id, uid, check)
+}
+
+// CompleteIdempotencyKey mocks base method.
+func (m *MockAppRepo) CompleteIdempotencyKey(ctx context.Context, k *models.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotencyKey", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotencyKey indicates an expected call of CompleteIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) CompleteIdempotencyKey(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).CompleteIdempotencyKey), ctx, k)
+}
+
+// CreateCity mocks base method.
+func (m *MockAppRepo) CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, req)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockAppRepoMockRecorder) CreateCity(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockAppRepo)(nil).CloseLastReception), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockAppRepo)(nil).CreateCity), ctx, req)
 }
 
 // CreatePVZ mocks base method.
@@ -90,19 +181,48 @@ func (mr *MockAppRepoMockRecorder) CreatePVZ(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockAppRepo)(nil).CreatePVZ), ctx, req)
 }
 
+// CreateProductType mocks base method.
+func (m *MockAppRepo) CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, req)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockAppRepoMockRecorder) CreateProductType(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockAppRepo)(nil).CreateProductType), ctx, req)
+}
+
 // CreateReception mocks base method.
-func (m *MockAppRepo) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error) {
+func (m *MockAppRepo) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq, uid uuid.UUID) (*dto.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReception", ctx, req)
+	ret := m.ctrl.Call(m, "CreateReception", ctx, req, uid)
 	ret0, _ := ret[0].(*dto.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateReception indicates an expected call of CreateReception.
-func (mr *MockAppRepoMockRecorder) CreateReception(ctx, req any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) CreateReception(ctx, req, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockAppRepo)(nil).CreateReception), ctx, req, uid)
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockAppRepo) CreateRefreshToken(ctx context.Context, uid uuid.UUID, role, hash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, uid, role, hash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockAppRepoMockRecorder) CreateRefreshToken(ctx, uid, role, hash, expiresAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockAppRepo)(nil).CreateReception), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockAppRepo)(nil).CreateRefreshToken), ctx, uid, role, hash, expiresAt)
 }
 
 // CreateUser mocks base method.
@@ -120,234 +240,1125 @@ func (mr *MockAppRepoMockRecorder) CreateUser(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAppRepo)(nil).CreateUser), ctx, req)
 }
 
-// DeleteLastProduct mocks base method.
-func (m *MockAppRepo) DeleteLastProduct(ctx context.Context, id uuid.UUID) error {
+// DecommissionPVZ mocks base method.
+func (m *MockAppRepo) DecommissionPVZ(ctx context.Context, id, uid uuid.UUID) (*dto.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, id)
+	ret := m.ctrl.Call(m, "DecommissionPVZ", ctx, id, uid)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionPVZ indicates an expected call of DecommissionPVZ.
+func (mr *MockAppRepoMockRecorder) DecommissionPVZ(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionPVZ", reflect.TypeOf((*MockAppRepo)(nil).DecommissionPVZ), ctx, id, uid)
+}
+
+// DeleteCity mocks base method.
+func (m *MockAppRepo) DeleteCity(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockAppRepoMockRecorder) DeleteCity(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockAppRepo)(nil).DeleteCity), ctx, name)
+}
+
+// DeleteLastProduct mocks base method.
+func (m *MockAppRepo) DeleteLastProduct(ctx context.Context, id, uid uuid.UUID) (*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, id, uid)
+	ret0, _ := ret[0].(*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // DeleteLastProduct indicates an expected call of DeleteLastProduct.
-func (mr *MockAppRepoMockRecorder) DeleteLastProduct(ctx, id any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) DeleteLastProduct(ctx, id, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockAppRepo)(nil).DeleteLastProduct), ctx, id, uid)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockAppRepo) DeleteProduct(ctx context.Context, receptionID, productID, uid uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, receptionID, productID, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockAppRepoMockRecorder) DeleteProduct(ctx, receptionID, productID, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockAppRepo)(nil).DeleteProduct), ctx, receptionID, productID, uid)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockAppRepo) DeleteProductType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockAppRepoMockRecorder) DeleteProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockAppRepo)(nil).DeleteProductType), ctx, name)
+}
+
+// FindNearbyPVZs mocks base method.
+func (m *MockAppRepo) FindNearbyPVZs(ctx context.Context, lat, lon, radius float64, after *models.NearbyCursor, limit int64) ([]*dto.NearbyPVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearbyPVZs", ctx, lat, lon, radius, after, limit)
+	ret0, _ := ret[0].([]*dto.NearbyPVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearbyPVZs indicates an expected call of FindNearbyPVZs.
+func (mr *MockAppRepoMockRecorder) FindNearbyPVZs(ctx, lat, lon, radius, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearbyPVZs", reflect.TypeOf((*MockAppRepo)(nil).FindNearbyPVZs), ctx, lat, lon, radius, after, limit)
+}
+
+// FindProductsByBarcode mocks base method.
+func (m *MockAppRepo) FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode)
+	ret0, _ := ret[0].([]*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockAppRepoMockRecorder) FindProductsByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockAppRepo)(nil).FindProductsByBarcode), ctx, barcode)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockAppRepo) GetIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) (*models.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", ctx, uid, key)
+	ret0, _ := ret[0].(*models.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) GetIdempotencyKey(ctx, uid, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockAppRepo)(nil).DeleteLastProduct), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).GetIdempotencyKey), ctx, uid, key)
+}
+
+// GetManifest mocks base method.
+func (m *MockAppRepo) GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", ctx, receptionID)
+	ret0, _ := ret[0].(*dto.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockAppRepoMockRecorder) GetManifest(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockAppRepo)(nil).GetManifest), ctx, receptionID)
 }
 
 // GetPVZ mocks base method.
-func (m *MockAppRepo) GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error) {
+func (m *MockAppRepo) GetPVZ(ctx context.Context, page, limit int64, after *models.PVZCursor, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZ", ctx, page, limit, startDate, endDate)
+	ret := m.ctrl.Call(m, "GetPVZ", ctx, page, limit, after, startDate, endDate, includeDeleted)
 	ret0, _ := ret[0].([]*dto.PvzGetOKItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZ indicates an expected call of GetPVZ.
-func (mr *MockAppRepoMockRecorder) GetPVZ(ctx, page, limit, startDate, endDate any) *gomock.Call {
+func (mr *MockAppRepoMockRecorder) GetPVZ(ctx, page, limit, after, startDate, endDate, includeDeleted any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZ", reflect.TypeOf((*MockAppRepo)(nil).GetPVZ), ctx, page, limit, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZ", reflect.TypeOf((*MockAppRepo)(nil).GetPVZ), ctx, page, limit, after, startDate, endDate, includeDeleted)
 }
 
-// GetPVZList mocks base method.
-func (m *MockAppRepo) GetPVZList(ctx context.Context) ([]*models.PVZ, error) {
+// GetPVZByID mocks base method.
+func (m *MockAppRepo) GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZList", ctx)
-	ret0, _ := ret[0].([]*models.PVZ)
+	ret := m.ctrl.Call(m, "GetPVZByID", ctx, id)
+	ret0, _ := ret[0].(*dto.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPVZList indicates an expected call of GetPVZList.
-func (mr *MockAppRepoMockRecorder) GetPVZList(ctx any) *gomock.Call {
+// GetPVZByID indicates an expected call of GetPVZByID.
+func (mr *MockAppRepoMockRecorder) GetPVZByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockAppRepo)(nil).GetPVZList), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZByID", reflect.TypeOf((*MockAppRepo)(nil).GetPVZByID), ctx, id)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockAppRepo) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+// GetPVZCity mocks base method.
+func (m *MockAppRepo) GetPVZCity(ctx context.Context, id uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
-	ret0, _ := ret[0].(*models.User)
+	ret := m.ctrl.Call(m, "GetPVZCity", ctx, id)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockAppRepoMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+// GetPVZCity indicates an expected call of GetPVZCity.
+func (mr *MockAppRepoMockRecorder) GetPVZCity(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAppRepo)(nil).GetUserByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZCity", reflect.TypeOf((*MockAppRepo)(nil).GetPVZCity), ctx, id)
 }
 
-// MockAppCtrl is a mock of AppCtrl interface.
-type MockAppCtrl struct {
-	ctrl     *gomock.Controller
-	recorder *MockAppCtrlMockRecorder
-	isgomock struct{}
+// GetPVZList mocks base method.
+func (m *MockAppRepo) GetPVZList(ctx context.Context, after *models.PVZCursor, limit int64) ([]*models.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZList", ctx, after, limit)
+	ret0, _ := ret[0].([]*models.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAppCtrlMockRecorder is the mock recorder for MockAppCtrl.
-type MockAppCtrlMockRecorder struct {
-	mock *MockAppCtrl
+// GetPVZList indicates an expected call of GetPVZList.
+func (mr *MockAppRepoMockRecorder) GetPVZList(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockAppRepo)(nil).GetPVZList), ctx, after, limit)
 }
 
-// NewMockAppCtrl creates a new mock instance.
-func NewMockAppCtrl(ctrl *gomock.Controller) *MockAppCtrl {
-	mock := &MockAppCtrl{ctrl: ctrl}
-	mock.recorder = &MockAppCtrlMockRecorder{mock}
-	return mock
+// GetReception mocks base method.
+func (m *MockAppRepo) GetReception(ctx context.Context, id uuid.UUID) (*models.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", ctx, id)
+	ret0, _ := ret[0].(*models.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAppCtrl) EXPECT() *MockAppCtrlMockRecorder {
-	return m.recorder
+// GetReception indicates an expected call of GetReception.
+func (mr *MockAppRepoMockRecorder) GetReception(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockAppRepo)(nil).GetReception), ctx, id)
 }
 
-// AddItemToReception mocks base method.
-func (m *MockAppCtrl) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error) {
+// GetReceptionProductCounts mocks base method.
+func (m *MockAppRepo) GetReceptionProductCounts(ctx context.Context, receptionID uuid.UUID) ([]*models.ProductCount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddItemToReception", ctx, req)
-	ret0, _ := ret[0].(*dto.Product)
+	ret := m.ctrl.Call(m, "GetReceptionProductCounts", ctx, receptionID)
+	ret0, _ := ret[0].([]*models.ProductCount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddItemToReception indicates an expected call of AddItemToReception.
-func (mr *MockAppCtrlMockRecorder) AddItemToReception(ctx, req any) *gomock.Call {
+// GetReceptionProductCounts indicates an expected call of GetReceptionProductCounts.
+func (mr *MockAppRepoMockRecorder) GetReceptionProductCounts(ctx, receptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToReception", reflect.TypeOf((*MockAppCtrl)(nil).AddItemToReception), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionProductCounts", reflect.TypeOf((*MockAppRepo)(nil).GetReceptionProductCounts), ctx, receptionID)
 }
 
-// CloseLastReception mocks base method.
-func (m *MockAppCtrl) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error) {
+// GetReceptionSummary mocks base method.
+func (m *MockAppRepo) GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseLastReception", ctx, id)
-	ret0, _ := ret[0].(*dto.Reception)
+	ret := m.ctrl.Call(m, "GetReceptionSummary", ctx, id)
+	ret0, _ := ret[0].(*dto.ReceptionSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CloseLastReception indicates an expected call of CloseLastReception.
-func (mr *MockAppCtrlMockRecorder) CloseLastReception(ctx, id any) *gomock.Call {
+// GetReceptionSummary indicates an expected call of GetReceptionSummary.
+func (mr *MockAppRepoMockRecorder) GetReceptionSummary(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockAppCtrl)(nil).CloseLastReception), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionSummary", reflect.TypeOf((*MockAppRepo)(nil).GetReceptionSummary), ctx, id)
 }
 
-// CreatePVZ mocks base method.
-func (m *MockAppCtrl) CreatePVZ(ctx context.Context, req *dto.PVZ) (*dto.PVZ, error) {
+// GetUserByEmail mocks base method.
+func (m *MockAppRepo) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePVZ", ctx, req)
-	ret0, _ := ret[0].(*dto.PVZ)
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePVZ indicates an expected call of CreatePVZ.
-func (mr *MockAppCtrlMockRecorder) CreatePVZ(ctx, req any) *gomock.Call {
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockAppRepoMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockAppCtrl)(nil).CreatePVZ), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAppRepo)(nil).GetUserByEmail), ctx, email)
 }
 
-// CreateReception mocks base method.
-func (m *MockAppCtrl) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error) {
+// IsAssigned mocks base method.
+func (m *MockAppRepo) IsAssigned(ctx context.Context, uid, pvzID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReception", ctx, req)
-	ret0, _ := ret[0].(*dto.Reception)
+	ret := m.ctrl.Call(m, "IsAssigned", ctx, uid, pvzID)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateReception indicates an expected call of CreateReception.
-func (mr *MockAppCtrlMockRecorder) CreateReception(ctx, req any) *gomock.Call {
+// IsAssigned indicates an expected call of IsAssigned.
+func (mr *MockAppRepoMockRecorder) IsAssigned(ctx, uid, pvzID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockAppCtrl)(nil).CreateReception), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAssigned", reflect.TypeOf((*MockAppRepo)(nil).IsAssigned), ctx, uid, pvzID)
 }
 
-// DeleteLastProduct mocks base method.
-func (m *MockAppCtrl) DeleteLastProduct(ctx context.Context, id uuid.UUID) error {
+// ListAssignments mocks base method.
+func (m *MockAppRepo) ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAssignments", ctx, uid)
+	ret0, _ := ret[0].([]*dto.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteLastProduct indicates an expected call of DeleteLastProduct.
-func (mr *MockAppCtrlMockRecorder) DeleteLastProduct(ctx, id any) *gomock.Call {
+// ListAssignments indicates an expected call of ListAssignments.
+func (mr *MockAppRepoMockRecorder) ListAssignments(ctx, uid any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockAppCtrl)(nil).DeleteLastProduct), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignments", reflect.TypeOf((*MockAppRepo)(nil).ListAssignments), ctx, uid)
 }
 
-// DummyLogin mocks base method.
-func (m *MockAppCtrl) DummyLogin(ctx context.Context, req *dto.DummyLoginPostReq) (dto.Token, error) {
+// ListAudit mocks base method.
+func (m *MockAppRepo) ListAudit(ctx context.Context, filter *models.AuditFilter) ([]*dto.AuditEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DummyLogin", ctx, req)
-	ret0, _ := ret[0].(dto.Token)
+	ret := m.ctrl.Call(m, "ListAudit", ctx, filter)
+	ret0, _ := ret[0].([]*dto.AuditEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DummyLogin indicates an expected call of DummyLogin.
-func (mr *MockAppCtrlMockRecorder) DummyLogin(ctx, req any) *gomock.Call {
+// ListAudit indicates an expected call of ListAudit.
+func (mr *MockAppRepoMockRecorder) ListAudit(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockAppCtrl)(nil).DummyLogin), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAudit", reflect.TypeOf((*MockAppRepo)(nil).ListAudit), ctx, filter)
 }
 
-// GetPVZ mocks base method.
-func (m *MockAppCtrl) GetPVZ(ctx context.Context, page, limit int64, startDate, endDate time.Time) ([]*dto.PvzGetOKItem, error) {
+// ListCities mocks base method.
+func (m *MockAppRepo) ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZ", ctx, page, limit, startDate, endDate)
-	ret0, _ := ret[0].([]*dto.PvzGetOKItem)
+	ret := m.ctrl.Call(m, "ListCities", ctx)
+	ret0, _ := ret[0].([]*dto.DictionaryEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPVZ indicates an expected call of GetPVZ.
-func (mr *MockAppCtrlMockRecorder) GetPVZ(ctx, page, limit, startDate, endDate any) *gomock.Call {
+// ListCities indicates an expected call of ListCities.
+func (mr *MockAppRepoMockRecorder) ListCities(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZ", reflect.TypeOf((*MockAppCtrl)(nil).GetPVZ), ctx, page, limit, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockAppRepo)(nil).ListCities), ctx)
 }
 
-// GetPVZList mocks base method.
-func (m *MockAppCtrl) GetPVZList(ctx context.Context) ([]*models.PVZ, error) {
+// ListProductTypes mocks base method.
+func (m *MockAppRepo) ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZList", ctx)
-	ret0, _ := ret[0].([]*models.PVZ)
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]*dto.DictionaryEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPVZList indicates an expected call of GetPVZList.
-func (mr *MockAppCtrlMockRecorder) GetPVZList(ctx any) *gomock.Call {
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockAppRepoMockRecorder) ListProductTypes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockAppCtrl)(nil).GetPVZList), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockAppRepo)(nil).ListProductTypes), ctx)
 }
 
-// Login mocks base method.
-func (m *MockAppCtrl) Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, error) {
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockAppRepo) ReleaseIdempotencyKey(ctx context.Context, uid uuid.UUID, key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, req)
-	ret0, _ := ret[0].(dto.Token)
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", ctx, uid, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockAppRepoMockRecorder) ReleaseIdempotencyKey(ctx, uid, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockAppRepo)(nil).ReleaseIdempotencyKey), ctx, uid, key)
+}
+
+// ReopenReception mocks base method.
+func (m *MockAppRepo) ReopenReception(ctx context.Context, id, uid uuid.UUID, reason string, window time.Duration) (*dto.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", ctx, id, uid, reason, window)
+	ret0, _ := ret[0].(*dto.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockAppCtrlMockRecorder) Login(ctx, req any) *gomock.Call {
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockAppRepoMockRecorder) ReopenReception(ctx, id, uid, reason, window any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAppCtrl)(nil).Login), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockAppRepo)(nil).ReopenReception), ctx, id, uid, reason, window)
 }
 
-// Register mocks base method.
-func (m *MockAppCtrl) Register(ctx context.Context, req *dto.RegisterPostReq) (*dto.User, error) {
+// ReplaceManifest mocks base method.
+func (m *MockAppRepo) ReplaceManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem, uid uuid.UUID) (*dto.Manifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", ctx, req)
-	ret0, _ := ret[0].(*dto.User)
+	ret := m.ctrl.Call(m, "ReplaceManifest", ctx, receptionID, strict, items, uid)
+	ret0, _ := ret[0].(*dto.Manifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Register indicates an expected call of Register.
-func (mr *MockAppCtrlMockRecorder) Register(ctx, req any) *gomock.Call {
+// ReplaceManifest indicates an expected call of ReplaceManifest.
+func (mr *MockAppRepoMockRecorder) ReplaceManifest(ctx, receptionID, strict, items, uid any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAppCtrl)(nil).Register), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceManifest", reflect.TypeOf((*MockAppRepo)(nil).ReplaceManifest), ctx, receptionID, strict, items, uid)
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *MockAppRepo) RevokeRefreshToken(ctx context.Context, hash string, uid uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", ctx, hash, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MockAppRepoMockRecorder) RevokeRefreshToken(ctx, hash, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MockAppRepo)(nil).RevokeRefreshToken), ctx, hash, uid)
+}
+
+// RevokeToken mocks base method.
+func (m *MockAppRepo) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockAppRepoMockRecorder) RevokeToken(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockAppRepo)(nil).RevokeToken), ctx, jti, expiresAt)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockAppRepo) RotateRefreshToken(ctx context.Context, hash, newHash string, expiresAt time.Time) (*models.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, hash, newHash, expiresAt)
+	ret0, _ := ret[0].(*models.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockAppRepoMockRecorder) RotateRefreshToken(ctx, hash, newHash, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockAppRepo)(nil).RotateRefreshToken), ctx, hash, newHash, expiresAt)
+}
+
+// SetCityActive mocks base method.
+func (m *MockAppRepo) SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCityActive", ctx, name, active)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCityActive indicates an expected call of SetCityActive.
+func (mr *MockAppRepoMockRecorder) SetCityActive(ctx, name, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCityActive", reflect.TypeOf((*MockAppRepo)(nil).SetCityActive), ctx, name, active)
+}
+
+// SetProductTypeActive mocks base method.
+func (m *MockAppRepo) SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTypeActive", ctx, name, active)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductTypeActive indicates an expected call of SetProductTypeActive.
+func (mr *MockAppRepoMockRecorder) SetProductTypeActive(ctx, name, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTypeActive", reflect.TypeOf((*MockAppRepo)(nil).SetProductTypeActive), ctx, name, active)
+}
+
+// UnassignPVZ mocks base method.
+func (m *MockAppRepo) UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignPVZ", ctx, uid, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignPVZ indicates an expected call of UnassignPVZ.
+func (mr *MockAppRepoMockRecorder) UnassignPVZ(ctx, uid, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignPVZ", reflect.TypeOf((*MockAppRepo)(nil).UnassignPVZ), ctx, uid, pvzID)
+}
+
+// UndoLastDelete mocks base method.
+func (m *MockAppRepo) UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoLastDelete", ctx, id)
+	ret0, _ := ret[0].(*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoLastDelete indicates an expected call of UndoLastDelete.
+func (mr *MockAppRepoMockRecorder) UndoLastDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoLastDelete", reflect.TypeOf((*MockAppRepo)(nil).UndoLastDelete), ctx, id)
+}
+
+// UpdatePVZ mocks base method.
+func (m *MockAppRepo) UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVZ", ctx, id, req)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePVZ indicates an expected call of UpdatePVZ.
+func (mr *MockAppRepoMockRecorder) UpdatePVZ(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVZ", reflect.TypeOf((*MockAppRepo)(nil).UpdatePVZ), ctx, id, req)
+}
+
+// MockAppCtrl is a mock of AppCtrl interface.
+type MockAppCtrl struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppCtrlMockRecorder
+	isgomock struct{}
+}
+
+// MockAppCtrlMockRecorder is the mock recorder for MockAppCtrl.
+type MockAppCtrlMockRecorder struct {
+	mock *MockAppCtrl
+}
+
+// NewMockAppCtrl creates a new mock instance.
+func NewMockAppCtrl(ctrl *gomock.Controller) *MockAppCtrl {
+	mock := &MockAppCtrl{ctrl: ctrl}
+	mock.recorder = &MockAppCtrlMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppCtrl) EXPECT() *MockAppCtrlMockRecorder {
+	return m.recorder
+}
+
+// AddItemToReception mocks base method.
+func (m *MockAppCtrl) AddItemToReception(ctx context.Context, req *dto.ProductsPostReq) (*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItemToReception", ctx, req)
+	ret0, _ := ret[0].(*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItemToReception indicates an expected call of AddItemToReception.
+func (mr *MockAppCtrlMockRecorder) AddItemToReception(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemToReception", reflect.TypeOf((*MockAppCtrl)(nil).AddItemToReception), ctx, req)
+}
+
+// AddItemsToReception mocks base method.
+func (m *MockAppCtrl) AddItemsToReception(ctx context.Context, req *dto.ProductsBatchPostReq) (*dto.ProductBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItemsToReception", ctx, req)
+	ret0, _ := ret[0].(*dto.ProductBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItemsToReception indicates an expected call of AddItemsToReception.
+func (mr *MockAppCtrlMockRecorder) AddItemsToReception(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItemsToReception", reflect.TypeOf((*MockAppCtrl)(nil).AddItemsToReception), ctx, req)
+}
+
+// AssignPVZ mocks base method.
+func (m *MockAppCtrl) AssignPVZ(ctx context.Context, uid, pvzID uuid.UUID) (*dto.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignPVZ", ctx, uid, pvzID)
+	ret0, _ := ret[0].(*dto.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignPVZ indicates an expected call of AssignPVZ.
+func (mr *MockAppCtrlMockRecorder) AssignPVZ(ctx, uid, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignPVZ", reflect.TypeOf((*MockAppCtrl)(nil).AssignPVZ), ctx, uid, pvzID)
+}
+
+// BeginIdempotent mocks base method.
+func (m *MockAppCtrl) BeginIdempotent(ctx context.Context, k *models.IdempotencyKey) (*models.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginIdempotent", ctx, k)
+	ret0, _ := ret[0].(*models.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginIdempotent indicates an expected call of BeginIdempotent.
+func (mr *MockAppCtrlMockRecorder) BeginIdempotent(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginIdempotent", reflect.TypeOf((*MockAppCtrl)(nil).BeginIdempotent), ctx, k)
+}
+
+// CloseLastReception mocks base method.
+func (m *MockAppCtrl) CloseLastReception(ctx context.Context, id uuid.UUID) (*dto.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseLastReception", ctx, id)
+	ret0, _ := ret[0].(*dto.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseLastReception indicates an expected call of CloseLastReception.
+func (mr *MockAppCtrlMockRecorder) CloseLastReception(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockAppCtrl)(nil).CloseLastReception), ctx, id)
+}
+
+// CompleteIdempotent mocks base method.
+func (m *MockAppCtrl) CompleteIdempotent(ctx context.Context, k *models.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteIdempotent", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteIdempotent indicates an expected call of CompleteIdempotent.
+func (mr *MockAppCtrlMockRecorder) CompleteIdempotent(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteIdempotent", reflect.TypeOf((*MockAppCtrl)(nil).CompleteIdempotent), ctx, k)
+}
+
+// CreateCity mocks base method.
+func (m *MockAppCtrl) CreateCity(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, req)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockAppCtrlMockRecorder) CreateCity(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockAppCtrl)(nil).CreateCity), ctx, req)
+}
+
+// CreatePVZ mocks base method.
+func (m *MockAppCtrl) CreatePVZ(ctx context.Context, req *dto.PVZ) (*dto.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePVZ", ctx, req)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePVZ indicates an expected call of CreatePVZ.
+func (mr *MockAppCtrlMockRecorder) CreatePVZ(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockAppCtrl)(nil).CreatePVZ), ctx, req)
+}
+
+// CreateProductType mocks base method.
+func (m *MockAppCtrl) CreateProductType(ctx context.Context, req *dto.DictionaryEntry) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, req)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockAppCtrlMockRecorder) CreateProductType(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockAppCtrl)(nil).CreateProductType), ctx, req)
+}
+
+// CreateReception mocks base method.
+func (m *MockAppCtrl) CreateReception(ctx context.Context, req *dto.ReceptionsPostReq) (*dto.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReception", ctx, req)
+	ret0, _ := ret[0].(*dto.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReception indicates an expected call of CreateReception.
+func (mr *MockAppCtrlMockRecorder) CreateReception(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockAppCtrl)(nil).CreateReception), ctx, req)
+}
+
+// DecommissionPVZ mocks base method.
+func (m *MockAppCtrl) DecommissionPVZ(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecommissionPVZ", ctx, id)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionPVZ indicates an expected call of DecommissionPVZ.
+func (mr *MockAppCtrlMockRecorder) DecommissionPVZ(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionPVZ", reflect.TypeOf((*MockAppCtrl)(nil).DecommissionPVZ), ctx, id)
+}
+
+// DeleteCity mocks base method.
+func (m *MockAppCtrl) DeleteCity(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockAppCtrlMockRecorder) DeleteCity(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockAppCtrl)(nil).DeleteCity), ctx, name)
+}
+
+// DeleteLastProduct mocks base method.
+func (m *MockAppCtrl) DeleteLastProduct(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLastProduct indicates an expected call of DeleteLastProduct.
+func (mr *MockAppCtrlMockRecorder) DeleteLastProduct(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockAppCtrl)(nil).DeleteLastProduct), ctx, id)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockAppCtrl) DeleteProduct(ctx context.Context, receptionID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, receptionID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockAppCtrlMockRecorder) DeleteProduct(ctx, receptionID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockAppCtrl)(nil).DeleteProduct), ctx, receptionID, productID)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockAppCtrl) DeleteProductType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockAppCtrlMockRecorder) DeleteProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockAppCtrl)(nil).DeleteProductType), ctx, name)
+}
+
+// DummyLogin mocks base method.
+func (m *MockAppCtrl) DummyLogin(ctx context.Context, req *dto.DummyLoginPostReq) (dto.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DummyLogin", ctx, req)
+	ret0, _ := ret[0].(dto.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DummyLogin indicates an expected call of DummyLogin.
+func (mr *MockAppCtrlMockRecorder) DummyLogin(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockAppCtrl)(nil).DummyLogin), ctx, req)
+}
+
+// FindNearbyPVZ mocks base method.
+func (m *MockAppCtrl) FindNearbyPVZ(ctx context.Context, lat, lon, radius float64, openNow bool, limit int64) ([]*dto.NearbyPVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearbyPVZ", ctx, lat, lon, radius, openNow, limit)
+	ret0, _ := ret[0].([]*dto.NearbyPVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearbyPVZ indicates an expected call of FindNearbyPVZ.
+func (mr *MockAppCtrlMockRecorder) FindNearbyPVZ(ctx, lat, lon, radius, openNow, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearbyPVZ", reflect.TypeOf((*MockAppCtrl)(nil).FindNearbyPVZ), ctx, lat, lon, radius, openNow, limit)
+}
+
+// FindProductsByBarcode mocks base method.
+func (m *MockAppCtrl) FindProductsByBarcode(ctx context.Context, barcode string) ([]*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode)
+	ret0, _ := ret[0].([]*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockAppCtrlMockRecorder) FindProductsByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockAppCtrl)(nil).FindProductsByBarcode), ctx, barcode)
+}
+
+// GetDiscrepancies mocks base method.
+func (m *MockAppCtrl) GetDiscrepancies(ctx context.Context, receptionID uuid.UUID) (*dto.DiscrepancyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscrepancies", ctx, receptionID)
+	ret0, _ := ret[0].(*dto.DiscrepancyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscrepancies indicates an expected call of GetDiscrepancies.
+func (mr *MockAppCtrlMockRecorder) GetDiscrepancies(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscrepancies", reflect.TypeOf((*MockAppCtrl)(nil).GetDiscrepancies), ctx, receptionID)
+}
+
+// GetManifest mocks base method.
+func (m *MockAppCtrl) GetManifest(ctx context.Context, receptionID uuid.UUID) (*dto.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", ctx, receptionID)
+	ret0, _ := ret[0].(*dto.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockAppCtrlMockRecorder) GetManifest(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockAppCtrl)(nil).GetManifest), ctx, receptionID)
+}
+
+// GetPVZ mocks base method.
+func (m *MockAppCtrl) GetPVZ(ctx context.Context, page, limit int64, cursor string, startDate, endDate time.Time, includeDeleted bool) ([]*dto.PvzGetOKItem, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZ", ctx, page, limit, cursor, startDate, endDate, includeDeleted)
+	ret0, _ := ret[0].([]*dto.PvzGetOKItem)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPVZ indicates an expected call of GetPVZ.
+func (mr *MockAppCtrlMockRecorder) GetPVZ(ctx, page, limit, cursor, startDate, endDate, includeDeleted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZ", reflect.TypeOf((*MockAppCtrl)(nil).GetPVZ), ctx, page, limit, cursor, startDate, endDate, includeDeleted)
+}
+
+// GetPVZByID mocks base method.
+func (m *MockAppCtrl) GetPVZByID(ctx context.Context, id uuid.UUID) (*dto.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZByID", ctx, id)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZByID indicates an expected call of GetPVZByID.
+func (mr *MockAppCtrlMockRecorder) GetPVZByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZByID", reflect.TypeOf((*MockAppCtrl)(nil).GetPVZByID), ctx, id)
+}
+
+// GetPVZList mocks base method.
+func (m *MockAppCtrl) GetPVZList(ctx context.Context, cursor string, limit int64) ([]*models.PVZ, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZList", ctx, cursor, limit)
+	ret0, _ := ret[0].([]*models.PVZ)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPVZList indicates an expected call of GetPVZList.
+func (mr *MockAppCtrlMockRecorder) GetPVZList(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockAppCtrl)(nil).GetPVZList), ctx, cursor, limit)
+}
+
+// GetReceptionSummary mocks base method.
+func (m *MockAppCtrl) GetReceptionSummary(ctx context.Context, id uuid.UUID) (*dto.ReceptionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionSummary", ctx, id)
+	ret0, _ := ret[0].(*dto.ReceptionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionSummary indicates an expected call of GetReceptionSummary.
+func (mr *MockAppCtrlMockRecorder) GetReceptionSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionSummary", reflect.TypeOf((*MockAppCtrl)(nil).GetReceptionSummary), ctx, id)
+}
+
+// ListAssignments mocks base method.
+func (m *MockAppCtrl) ListAssignments(ctx context.Context, uid uuid.UUID) ([]*dto.PVZAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignments", ctx, uid)
+	ret0, _ := ret[0].([]*dto.PVZAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssignments indicates an expected call of ListAssignments.
+func (mr *MockAppCtrlMockRecorder) ListAssignments(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignments", reflect.TypeOf((*MockAppCtrl)(nil).ListAssignments), ctx, uid)
+}
+
+// ListAudit mocks base method.
+func (m *MockAppCtrl) ListAudit(ctx context.Context, filter *models.AuditFilter) ([]*dto.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAudit", ctx, filter)
+	ret0, _ := ret[0].([]*dto.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAudit indicates an expected call of ListAudit.
+func (mr *MockAppCtrlMockRecorder) ListAudit(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAudit", reflect.TypeOf((*MockAppCtrl)(nil).ListAudit), ctx, filter)
+}
+
+// ListCities mocks base method.
+func (m *MockAppCtrl) ListCities(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", ctx)
+	ret0, _ := ret[0].([]*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockAppCtrlMockRecorder) ListCities(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockAppCtrl)(nil).ListCities), ctx)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockAppCtrl) ListProductTypes(ctx context.Context) ([]*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockAppCtrlMockRecorder) ListProductTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockAppCtrl)(nil).ListProductTypes), ctx)
+}
+
+// Login mocks base method.
+func (m *MockAppCtrl) Login(ctx context.Context, req *dto.LoginPostReq) (dto.Token, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, req)
+	ret0, _ := ret[0].(dto.Token)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAppCtrlMockRecorder) Login(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAppCtrl)(nil).Login), ctx, req)
+}
+
+// Logout mocks base method.
+func (m *MockAppCtrl) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAppCtrlMockRecorder) Logout(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAppCtrl)(nil).Logout), ctx, refreshToken)
+}
+
+// Refresh mocks base method.
+func (m *MockAppCtrl) Refresh(ctx context.Context, refreshToken string) (dto.Token, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(dto.Token)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAppCtrlMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAppCtrl)(nil).Refresh), ctx, refreshToken)
+}
+
+// Register mocks base method.
+func (m *MockAppCtrl) Register(ctx context.Context, req *dto.RegisterPostReq) (*dto.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, req)
+	ret0, _ := ret[0].(*dto.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAppCtrlMockRecorder) Register(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAppCtrl)(nil).Register), ctx, req)
+}
+
+// ReleaseIdempotent mocks base method.
+func (m *MockAppCtrl) ReleaseIdempotent(ctx context.Context, k *models.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotent", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotent indicates an expected call of ReleaseIdempotent.
+func (mr *MockAppCtrlMockRecorder) ReleaseIdempotent(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotent", reflect.TypeOf((*MockAppCtrl)(nil).ReleaseIdempotent), ctx, k)
+}
+
+// ReopenReception mocks base method.
+func (m *MockAppCtrl) ReopenReception(ctx context.Context, id uuid.UUID, reason string) (*dto.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", ctx, id, reason)
+	ret0, _ := ret[0].(*dto.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockAppCtrlMockRecorder) ReopenReception(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockAppCtrl)(nil).ReopenReception), ctx, id, reason)
+}
+
+// SetCityActive mocks base method.
+func (m *MockAppCtrl) SetCityActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCityActive", ctx, name, active)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCityActive indicates an expected call of SetCityActive.
+func (mr *MockAppCtrlMockRecorder) SetCityActive(ctx, name, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCityActive", reflect.TypeOf((*MockAppCtrl)(nil).SetCityActive), ctx, name, active)
+}
+
+// SetProductTypeActive mocks base method.
+func (m *MockAppCtrl) SetProductTypeActive(ctx context.Context, name string, active bool) (*dto.DictionaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTypeActive", ctx, name, active)
+	ret0, _ := ret[0].(*dto.DictionaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductTypeActive indicates an expected call of SetProductTypeActive.
+func (mr *MockAppCtrlMockRecorder) SetProductTypeActive(ctx, name, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTypeActive", reflect.TypeOf((*MockAppCtrl)(nil).SetProductTypeActive), ctx, name, active)
+}
+
+// UnassignPVZ mocks base method.
+func (m *MockAppCtrl) UnassignPVZ(ctx context.Context, uid, pvzID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignPVZ", ctx, uid, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignPVZ indicates an expected call of UnassignPVZ.
+func (mr *MockAppCtrlMockRecorder) UnassignPVZ(ctx, uid, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignPVZ", reflect.TypeOf((*MockAppCtrl)(nil).UnassignPVZ), ctx, uid, pvzID)
+}
+
+// UndoLastDelete mocks base method.
+func (m *MockAppCtrl) UndoLastDelete(ctx context.Context, id uuid.UUID) (*dto.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoLastDelete", ctx, id)
+	ret0, _ := ret[0].(*dto.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoLastDelete indicates an expected call of UndoLastDelete.
+func (mr *MockAppCtrlMockRecorder) UndoLastDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoLastDelete", reflect.TypeOf((*MockAppCtrl)(nil).UndoLastDelete), ctx, id)
+}
+
+// UpdatePVZ mocks base method.
+func (m *MockAppCtrl) UpdatePVZ(ctx context.Context, id uuid.UUID, req *dto.PVZUpdate) (*dto.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVZ", ctx, id, req)
+	ret0, _ := ret[0].(*dto.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePVZ indicates an expected call of UpdatePVZ.
+func (mr *MockAppCtrlMockRecorder) UpdatePVZ(ctx, id, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVZ", reflect.TypeOf((*MockAppCtrl)(nil).UpdatePVZ), ctx, id, req)
+}
+
+// UploadManifest mocks base method.
+func (m *MockAppCtrl) UploadManifest(ctx context.Context, receptionID uuid.UUID, strict bool, items []dto.ManifestItem) (*dto.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadManifest", ctx, receptionID, strict, items)
+	ret0, _ := ret[0].(*dto.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadManifest indicates an expected call of UploadManifest.
+func (mr *MockAppCtrlMockRecorder) UploadManifest(ctx, receptionID, strict, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadManifest", reflect.TypeOf((*MockAppCtrl)(nil).UploadManifest), ctx, receptionID, strict, items)
+}
+
+// WatchPVZ mocks base method.
+func (m *MockAppCtrl) WatchPVZ(ctx context.Context, pvzID uuid.UUID, city string) (<-chan *models.Event, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPVZ", ctx, pvzID, city)
+	ret0, _ := ret[0].(<-chan *models.Event)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// WatchPVZ indicates an expected call of WatchPVZ.
+func (mr *MockAppCtrlMockRecorder) WatchPVZ(ctx, pvzID, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPVZ", reflect.TypeOf((*MockAppCtrl)(nil).WatchPVZ), ctx, pvzID, city)
 }